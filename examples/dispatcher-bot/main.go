@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/WORKHATERS/gote/internal/env"
+	"github.com/WORKHATERS/gote/pkg/core"
+	"github.com/WORKHATERS/gote/pkg/dispatcher"
+	"github.com/WORKHATERS/gote/pkg/types"
+	"github.com/WORKHATERS/gote/pkg/updater"
+)
+
+func main() {
+	// получение ключа бота из файла .env
+	// BOT_TOKEN=токен_из_BotFather
+	_ = env.Load(".env")
+	token := os.Getenv("BOT_TOKEN")
+	if token == "" {
+		panic("Токен отсутствует")
+	}
+
+	// создание контекста
+	ctx, closeFunc := context.WithCancel(context.Background())
+	defer closeFunc()
+
+	// создание бота
+	b := core.NewBot(ctx, token)
+
+	// регистрация обработчиков
+	d := dispatcher.NewDispatcher(b)
+	d.Use(dispatcher.Recover(), dispatcher.Logging())
+
+	d.OnCommand("start", func(c *dispatcher.Context) error {
+		_, err := c.Bot.SendMessage(c, types.SendMessage{
+			ChatId: types.ChatIDInt(c.Chat().Id),
+			Text:   "Выберите вариант",
+			ReplyMarkup: types.InlineKeyboardMarkup{
+				InlineKeyboard: [][]types.InlineKeyboardButton{{
+					{Text: "1", CallbackData: "choice:1"},
+					{Text: "2", CallbackData: "choice:2"},
+				}},
+			},
+		})
+		return err
+	}, dispatcher.ChatType(dispatcher.ChatPrivate))
+
+	d.OnCallbackQuery(func(c *dispatcher.Context) error {
+		_, err := c.Reply("Вы выбрали: " + c.Text())
+		return err
+	}, dispatcher.CallbackPrefix("choice:"))
+
+	d.OnMessage(func(c *dispatcher.Context) error {
+		_, err := c.Reply(c.Text())
+		return err
+	}, dispatcher.ContentType(dispatcher.ContentText))
+
+	// получение и обработка обновлений от Telegram
+	_ = d.Run(ctx, updater.NewPoller(b))
+}
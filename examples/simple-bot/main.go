@@ -34,16 +34,13 @@ func main() {
 	updates := poller.Start()
 	for u := range updates {
 		if cb := u.CallbackQuery; cb != nil {
-			message, err := types.CastTo[types.Message](cb.Message)
-			if err != nil {
-				b.Logger().Error(err.Error())
+			// сообщение может быть недоступным (types.InaccessibleMessage)
+			if message, ok := cb.Message.(*types.Message); ok {
+				b.SendMessage(ctx, types.SendMessage{
+					ChatId: types.ChatIDInt(message.Chat.Id),
+					Text:   "Вы выбрали: " + u.CallbackQuery.Data,
+				})
 			}
-
-			b.SendMessage(ctx, types.SendMessage{
-				ChatId: message.Chat.Id,
-				Text:   "Вы выбрали: " + u.CallbackQuery.Data,
-			})
-
 		}
 
 		if msg := u.Message; msg != nil {
@@ -59,7 +56,7 @@ func main() {
 			}
 
 			b.SendMessage(ctx, types.SendMessage{
-				ChatId: u.Message.Chat.Id,
+				ChatId: types.ChatIDInt(u.Message.Chat.Id),
 				Text:   msg.Text,
 				ReplyMarkup: types.InlineKeyboardMarkup{
 					InlineKeyboard: [][]types.InlineKeyboardButton{
@@ -5,8 +5,17 @@ import (
 	"log/slog"
 	"net/http"
 	"os"
+	"strings"
 )
 
+// DefaultAPIEndpoint адрес Telegram Bot API по умолчанию
+const DefaultAPIEndpoint = "https://api.telegram.org"
+
+// DefaultMaxResponseSize максимальный размер ответа Telegram Bot API по умолчанию
+const DefaultMaxResponseSize = 32 << 20
+
+var _ Caller = (*Bot)(nil)
+
 // Bot структура бота
 type Bot struct {
 	ctx    context.Context
@@ -16,6 +25,16 @@ type Bot struct {
 	client HTTPClient
 	logger Logger
 	debug  bool
+
+	apiEndpoint  string
+	fileEndpoint string
+	testEnv      bool
+
+	maxResponseSize int64
+
+	middlewares []Middleware
+	caller      Caller
+	retry       *RetryPolicy
 }
 
 // NewBot функция для создания бота
@@ -28,6 +47,9 @@ func NewBot(ctx context.Context, token string, opts ...Option) *Bot {
 
 		token: token,
 		debug: false,
+
+		apiEndpoint:     DefaultAPIEndpoint,
+		maxResponseSize: DefaultMaxResponseSize,
 	}
 
 	for _, opt := range opts {
@@ -39,9 +61,19 @@ func NewBot(ctx context.Context, token string, opts ...Option) *Bot {
 	}
 
 	if b.logger == nil {
-		b.logger = slog.New(slog.NewJSONHandler(os.Stdout, nil))
+		opts := &slog.HandlerOptions{}
+		if b.debug {
+			opts.Level = slog.LevelDebug
+		}
+		b.logger = slog.New(slog.NewJSONHandler(os.Stdout, opts))
 	}
 
+	if b.fileEndpoint == "" {
+		b.fileEndpoint = b.apiEndpoint
+	}
+
+	b.caller = chain(CallerFunc(b.execute), b.middlewares)
+
 	return b
 }
 
@@ -58,11 +90,62 @@ func WithHTTPClient(c HTTPClient) Option {
 	return func(b *Bot) { b.client = c }
 }
 
-// WithDebug функция установки значения для дебаг режима
+// WithDebug функция установки значения для дебаг режима.
+// В дебаг режиме каждый вызов API логируется с уровнем Debug.
 func WithDebug(on bool) Option {
 	return func(b *Bot) { b.debug = on }
 }
 
+// WithAPIEndpoint функция установки адреса Bot API, например локального сервера telegram-bot-api
+func WithAPIEndpoint(endpoint string) Option {
+	return func(b *Bot) { b.apiEndpoint = strings.TrimRight(endpoint, "/") }
+}
+
+// WithFileEndpoint функция установки адреса для скачивания файлов.
+// По умолчанию совпадает с адресом Bot API.
+func WithFileEndpoint(endpoint string) Option {
+	return func(b *Bot) { b.fileEndpoint = strings.TrimRight(endpoint, "/") }
+}
+
+// WithTestEnvironment функция включения тестового окружения Telegram
+func WithTestEnvironment(on bool) Option {
+	return func(b *Bot) { b.testEnv = on }
+}
+
+// WithMaxResponseSize функция установки максимального размера ответа Bot API в байтах
+func WithMaxResponseSize(size int64) Option {
+	return func(b *Bot) { b.maxResponseSize = size }
+}
+
+// WithMiddleware функция добавления промежуточных обработчиков вызовов API
+func WithMiddleware(mws ...Middleware) Option {
+	return func(b *Bot) { b.middlewares = append(b.middlewares, mws...) }
+}
+
+// Call метод вызова произвольного метода Telegram Bot API.
+// Использует тот же адрес, HTTP-клиент, промежуточные обработчики и декодирование ответа,
+// что и сгенерированные методы. result должен быть указателем или nil.
+func (b *Bot) Call(ctx context.Context, method string, params any, result any) error {
+	return b.caller.Call(ctx, method, params, result)
+}
+
+// MethodURL метод получения адреса метода Bot API
+func (b *Bot) MethodURL(method string) string {
+	return b.apiEndpoint + "/bot" + b.token + b.envPath() + "/" + method
+}
+
+// FileURL метод получения адреса для скачивания файла по его file_path
+func (b *Bot) FileURL(filePath string) string {
+	return b.fileEndpoint + "/file/bot" + b.token + b.envPath() + "/" + strings.TrimLeft(filePath, "/")
+}
+
+func (b *Bot) envPath() string {
+	if b.testEnv {
+		return "/test"
+	}
+	return ""
+}
+
 // Context метод получения контекста
 func (b *Bot) Context() context.Context { return b.ctx }
 
@@ -0,0 +1,306 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// ErrUnexpectedCall запрос не совпадает со следующим записанным взаимодействием
+var ErrUnexpectedCall = errors.New("неожиданный вызов при воспроизведении")
+
+// attachNameRe выражение для имён вложений, которые различаются между запусками
+var attachNameRe = regexp.MustCompile(`attach://file\d+\b`)
+
+// Interaction структура одного записанного вызова Bot API
+type Interaction struct {
+	// Имя метода или "file" для скачивания файла
+	Method string `json:"method"`
+
+	// Параметры запроса без токена
+	Params json.RawMessage `json:"params,omitempty"`
+
+	// HTTP-статус ответа
+	Status int `json:"status"`
+
+	// Тело ответа, если это JSON
+	Response json.RawMessage `json:"response,omitempty"`
+
+	// Тело ответа, если это не JSON (например, содержимое файла)
+	Body []byte `json:"body,omitempty"`
+}
+
+// Cassette структура записи взаимодействий с Bot API
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// LoadCassette функция загрузки записи из файла
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var c Cassette
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("некорректный файл записи %s: %w", path, err)
+	}
+
+	return &c, nil
+}
+
+// Save метод сохранения записи в файл
+func (c *Cassette) Save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// Recorder структура HTTP-клиента, записывающего все вызовы Bot API.
+// Токен бота вырезается из записанных параметров и ответов.
+type Recorder struct {
+	next  HTTPClient
+	token string
+
+	mu       sync.Mutex
+	cassette Cassette
+}
+
+// NewRecorder функция-конструктор для Recorder.
+// next - клиент, выполняющий реальные запросы; если nil, используется http.DefaultClient.
+func NewRecorder(next HTTPClient, token string) *Recorder {
+	if next == nil {
+		next = http.DefaultClient
+	}
+	return &Recorder{next: next, token: token}
+}
+
+// Do метод выполнения запроса с записью запроса и ответа
+func (r *Recorder) Do(req *http.Request) (*http.Response, error) {
+	method, params, err := readInteractionRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.next.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	in := Interaction{
+		Method: method,
+		Params: r.redact(params),
+		Status: resp.StatusCode,
+	}
+	if json.Valid(body) {
+		in.Response = r.redact(body)
+	} else {
+		in.Body = body
+	}
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, in)
+	r.mu.Unlock()
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// Cassette метод получения копии записанных взаимодействий
+func (r *Recorder) Cassette() *Cassette {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return &Cassette{Interactions: append([]Interaction(nil), r.cassette.Interactions...)}
+}
+
+// Save метод сохранения записанных взаимодействий в файл
+func (r *Recorder) Save(path string) error {
+	return r.Cassette().Save(path)
+}
+
+func (r *Recorder) redact(data []byte) []byte {
+	if r.token == "" || data == nil {
+		return data
+	}
+	return bytes.ReplaceAll(data, []byte(r.token), []byte(redactedToken))
+}
+
+// Replayer структура HTTP-клиента, воспроизводящего записанные взаимодействия без обращения к сети.
+// Запросы должны идти в том же порядке и с теми же параметрами, что и при записи,
+// иначе возвращается ErrUnexpectedCall.
+type Replayer struct {
+	mu       sync.Mutex
+	cassette *Cassette
+	next     int
+}
+
+// NewReplayer функция-конструктор для Replayer
+func NewReplayer(c *Cassette) *Replayer {
+	return &Replayer{cassette: c}
+}
+
+// NewReplayerFromFile функция создания Replayer из файла записи
+func NewReplayerFromFile(path string) (*Replayer, error) {
+	c, err := LoadCassette(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewReplayer(c), nil
+}
+
+// Do метод получения записанного ответа на запрос
+func (r *Replayer) Do(req *http.Request) (*http.Response, error) {
+	method, params, err := readInteractionRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.next >= len(r.cassette.Interactions) {
+		return nil, fmt.Errorf("%w: %s, все записанные взаимодействия уже воспроизведены", ErrUnexpectedCall, method)
+	}
+
+	in := r.cassette.Interactions[r.next]
+	if in.Method != method {
+		return nil, fmt.Errorf("%w: ожидался %s, получен %s", ErrUnexpectedCall, in.Method, method)
+	}
+	if !equalParams(in.Params, params) {
+		return nil, fmt.Errorf("%w: параметры %s не совпадают: ожидалось %s, получено %s", ErrUnexpectedCall, method, in.Params, params)
+	}
+	r.next++
+
+	body := in.Body
+	if in.Response != nil {
+		body = in.Response
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", in.Status, http.StatusText(in.Status)),
+		StatusCode:    in.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        make(http.Header),
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// Remaining метод получения количества ещё не воспроизведённых взаимодействий
+func (r *Replayer) Remaining() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.cassette.Interactions) - r.next
+}
+
+// readInteractionRequest функция получения имени метода и параметров запроса для записи.
+// Тело запроса читается полностью и подменяется копией.
+func readInteractionRequest(req *http.Request) (string, json.RawMessage, error) {
+	path := req.URL.Path
+	if i := strings.Index(path, "/file/bot"); i >= 0 {
+		_, filePath, _ := strings.Cut(path[i+len("/file/bot"):], "/")
+		filePath = strings.TrimPrefix(filePath, "test/")
+		params, err := json.Marshal(map[string]string{"file_path": filePath})
+		return "file", params, err
+	}
+
+	method := path[strings.LastIndex(path, "/")+1:]
+
+	if req.Body == nil {
+		return method, nil, nil
+	}
+
+	data, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return "", nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	req.ContentLength = int64(len(data))
+
+	mediaType, mediaParams, _ := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if mediaType != "multipart/form-data" {
+		return method, normalizeParams(data), nil
+	}
+
+	fields := make(map[string]any)
+	mr := multipart.NewReader(bytes.NewReader(data), mediaParams["boundary"])
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", nil, err
+		}
+
+		value, err := io.ReadAll(part)
+		if err != nil {
+			return "", nil, err
+		}
+
+		name := part.FormName()
+		if part.FileName() == "" {
+			fields[name] = string(value)
+			continue
+		}
+
+		// имена вложений различаются между запусками, поэтому вложенные файлы записываются по имени файла
+		if attachNameRe.MatchString("attach://" + name) {
+			name = "file:" + part.FileName()
+		}
+		fields[name] = map[string]any{"file_name": part.FileName(), "size": len(value)}
+	}
+
+	params, err := json.Marshal(fields)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return method, normalizeParams(params), nil
+}
+
+// normalizeParams функция замены имён вложений attach://fileN на постоянное значение
+func normalizeParams(data []byte) json.RawMessage {
+	return attachNameRe.ReplaceAll(data, []byte("attach://file"))
+}
+
+// equalParams функция сравнения параметров запросов без учёта порядка полей
+func equalParams(a, b json.RawMessage) bool {
+	if bytes.Equal(a, b) {
+		return true
+	}
+
+	var va, vb any
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		return false
+	}
+
+	ca, _ := json.Marshal(va)
+	cb, _ := json.Marshal(vb)
+
+	return bytes.Equal(ca, cb)
+}
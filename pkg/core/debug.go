@@ -0,0 +1,88 @@
+package core
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// redactedToken строка, которой заменяется токен бота в логах и ошибках
+const redactedToken = "<token>"
+
+// maxLoggedParams максимальная длина параметров метода в логе
+const maxLoggedParams = 1024
+
+// redact метод удаления токена бота из строки
+func (b *Bot) redact(s string) string {
+	if b.token == "" {
+		return s
+	}
+	return strings.ReplaceAll(s, b.token, redactedToken)
+}
+
+// redactError метод удаления токена бота из ошибки HTTP-клиента.
+// *url.Error содержит полный адрес запроса, а значит и токен.
+func (b *Bot) redactError(err error) error {
+	if urlErr, ok := err.(*url.Error); ok {
+		redacted := *urlErr
+		redacted.URL = b.redact(urlErr.URL)
+		err = &redacted
+	}
+
+	if msg := b.redact(err.Error()); msg != err.Error() {
+		return &redactedError{err: err, msg: msg}
+	}
+
+	return err
+}
+
+// redactedError структура ошибки с удалённым из текста токеном.
+// Исходная ошибка доступна через errors.Is и errors.As.
+type redactedError struct {
+	err error
+	msg string
+}
+
+func (e *redactedError) Error() string { return e.msg }
+
+func (e *redactedError) Unwrap() error { return e.err }
+
+// logCall метод логирования вызова метода API в дебаг режиме
+func (b *Bot) logCall(method string, params any, status int, latency time.Duration, err error) {
+	attrs := []any{
+		"method", method,
+		"params", b.sanitizeParams(params),
+		"latency", latency,
+	}
+	if status != 0 {
+		attrs = append(attrs, "status", status)
+	}
+
+	if err != nil {
+		b.logger.Debug("Ошибка вызова метода API", append(attrs, "error", err)...)
+		return
+	}
+
+	b.logger.Debug("Вызов метода API", attrs...)
+}
+
+// sanitizeParams метод получения параметров метода для лога:
+// JSON без токена, загружаемые файлы представлены ссылками attach://, длинные значения обрезаны
+func (b *Bot) sanitizeParams(params any) string {
+	if params == nil {
+		return "{}"
+	}
+
+	data, err := json.Marshal(params)
+	if err != nil {
+		return "<" + err.Error() + ">"
+	}
+
+	s := b.redact(string(data))
+	if len(s) > maxLoggedParams {
+		s = s[:maxLoggedParams] + "..."
+	}
+
+	return s
+}
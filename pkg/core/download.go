@@ -0,0 +1,130 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/WORKHATERS/gote/pkg/types"
+)
+
+// ErrFileSize размер скачанного файла не совпадает с File.FileSize
+var ErrFileSize = errors.New("размер файла не совпадает с ожидаемым")
+
+// DownloadFile метод скачивания файла по file_id в w.
+// Содержимое копируется потоком, без буферизации всего файла в памяти.
+func (bot *Bot) DownloadFile(ctx context.Context, fileId string, w io.Writer) error {
+	r, err := bot.OpenFile(ctx, fileId)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	_, err = io.Copy(w, r)
+	return err
+}
+
+// OpenFile метод открытия файла по file_id для чтения.
+// Вызывающая сторона обязана закрыть возвращённый io.ReadCloser.
+func (bot *Bot) OpenFile(ctx context.Context, fileId string) (io.ReadCloser, error) {
+	file, err := bot.GetFile(ctx, types.GetFile{FileId: fileId})
+	if err != nil {
+		return nil, err
+	}
+
+	return bot.OpenTelegramFile(ctx, file)
+}
+
+// OpenTelegramFile метод открытия файла, полученного методом GetFile.
+// Локальный сервер Bot API (режим --local) возвращает абсолютный путь на диске,
+// такой файл открывается напрямую из файловой системы.
+func (bot *Bot) OpenTelegramFile(ctx context.Context, file *types.File) (io.ReadCloser, error) {
+	if file == nil || file.FilePath == "" {
+		return nil, errors.New("у файла нет file_path, скачивание недоступно")
+	}
+
+	if filepath.IsAbs(file.FilePath) {
+		f, err := os.Open(file.FilePath)
+		if err != nil {
+			return nil, err
+		}
+		return newSizeReader(f, file.FileSize), nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, bot.FileURL(file.FilePath), nil)
+	if err != nil {
+		return nil, bot.redactError(err)
+	}
+
+	resp, err := bot.client.Do(req)
+	if err != nil {
+		return nil, bot.redactError(err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		return nil, fileResponseError(resp)
+	}
+
+	if file.FileSize > 0 && resp.ContentLength >= 0 && resp.ContentLength != file.FileSize {
+		resp.Body.Close()
+		return nil, fmt.Errorf("%w: ожидалось %d байт, сервер сообщил %d", ErrFileSize, file.FileSize, resp.ContentLength)
+	}
+
+	return newSizeReader(resp.Body, file.FileSize), nil
+}
+
+// fileResponseError функция получения ошибки из неуспешного ответа при скачивании файла
+func fileResponseError(resp *http.Response) error {
+	var response tgResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<16)).Decode(&response); err == nil && response.ErrorCode != 0 {
+		return newAPIError(response.ErrorCode, response.Description, response.Parameters)
+	}
+
+	return newAPIError(resp.StatusCode, http.StatusText(resp.StatusCode), nil)
+}
+
+// sizeReader структура проверки размера читаемого файла.
+// Не позволяет прочитать больше ожидаемого и сообщает об усечённом файле при EOF.
+type sizeReader struct {
+	rc   io.ReadCloser
+	size int64
+	read int64
+}
+
+func newSizeReader(rc io.ReadCloser, size int64) io.ReadCloser {
+	if size <= 0 {
+		return rc
+	}
+	return &sizeReader{rc: rc, size: size}
+}
+
+// Read метод чтения содержимого файла с проверкой размера
+func (r *sizeReader) Read(p []byte) (int, error) {
+	if left := r.size - r.read + 1; int64(len(p)) > left {
+		p = p[:left]
+	}
+
+	n, err := r.rc.Read(p)
+	r.read += int64(n)
+
+	if r.read > r.size {
+		n -= int(r.read - r.size)
+		return n, fmt.Errorf("%w: получено больше %d байт", ErrFileSize, r.size)
+	}
+	if err == io.EOF && r.read != r.size {
+		return n, fmt.Errorf("%w: ожидалось %d байт, получено %d", ErrFileSize, r.size, r.read)
+	}
+
+	return n, err
+}
+
+// Close метод закрытия файла
+func (r *sizeReader) Close() error {
+	return r.rc.Close()
+}
@@ -0,0 +1,94 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/WORKHATERS/gote/pkg/types"
+)
+
+// Типовые ошибки Telegram Bot API для проверки через errors.Is
+var (
+	// ErrForbidden бот заблокирован пользователем, исключён из чата или не имеет прав
+	ErrForbidden = errors.New("доступ запрещён")
+
+	// ErrChatNotFound чат не найден
+	ErrChatNotFound = errors.New("чат не найден")
+
+	// ErrMessageNotModified новое содержимое сообщения совпадает с текущим
+	ErrMessageNotModified = errors.New("сообщение не изменено")
+
+	// ErrTooManyRequests превышен лимит запросов (flood control)
+	ErrTooManyRequests = errors.New("слишком много запросов")
+
+	// ErrUnauthorized неверный или отозванный токен бота
+	ErrUnauthorized = errors.New("неверный токен бота")
+
+	// ErrConflict конфликт получения обновлений: установлен webhook или запущен другой экземпляр getUpdates
+	ErrConflict = errors.New("конфликт получения обновлений")
+)
+
+// APIError структура ошибки, возвращаемой Telegram при ok=false
+type APIError struct {
+	// Код ошибки (error_code)
+	Code int
+
+	// Человекочитаемое описание ошибки (description)
+	Description string
+
+	// Дополнительные параметры для автоматической обработки ошибки
+	Parameters *types.ResponseParameters
+}
+
+func newAPIError(code int, description string, params *types.ResponseParameters) *APIError {
+	return &APIError{
+		Code:        code,
+		Description: description,
+		Parameters:  params,
+	}
+}
+
+// Error метод получения текста ошибки
+func (e *APIError) Error() string {
+	return fmt.Sprintf("telegram: %d %s", e.Code, e.Description)
+}
+
+// Is метод сопоставления ошибки с типовыми ошибками пакета
+func (e *APIError) Is(target error) bool {
+	description := strings.ToLower(e.Description)
+
+	switch target {
+	case ErrForbidden:
+		return e.Code == 403
+	case ErrChatNotFound:
+		return e.Code == 400 && strings.Contains(description, "chat not found")
+	case ErrMessageNotModified:
+		return e.Code == 400 && strings.Contains(description, "message is not modified")
+	case ErrTooManyRequests:
+		return e.Code == 429
+	case ErrUnauthorized:
+		return e.Code == 401
+	case ErrConflict:
+		return e.Code == 409
+	}
+
+	return false
+}
+
+// RetryAfter метод получения времени ожидания перед повторным запросом
+func (e *APIError) RetryAfter() time.Duration {
+	if e.Parameters == nil {
+		return 0
+	}
+	return time.Duration(e.Parameters.RetryAfter) * time.Second
+}
+
+// MigrateToChatId метод получения идентификатора супергруппы, в которую была преобразована группа
+func (e *APIError) MigrateToChatId() int64 {
+	if e.Parameters == nil {
+		return 0
+	}
+	return e.Parameters.MigrateToChatId
+}
@@ -0,0 +1,30 @@
+package core
+
+import "context"
+
+// Caller интерфейс выполнения вызова метода Telegram Bot API.
+// params - параметры метода, result - указатель на значение, в которое декодируется результат.
+type Caller interface {
+	Call(ctx context.Context, method string, params any, result any) error
+}
+
+// CallerFunc тип-адаптер для использования функции в качестве Caller
+type CallerFunc func(ctx context.Context, method string, params any, result any) error
+
+// Call метод вызова функции
+func (f CallerFunc) Call(ctx context.Context, method string, params any, result any) error {
+	return f(ctx, method, params, result)
+}
+
+// Middleware тип промежуточного обработчика вызовов методов API.
+// Позволяет добавить повторы, кеширование, аудит и т.д. для всех методов сразу.
+type Middleware func(next Caller) Caller
+
+// chain функция построения цепочки промежуточных обработчиков.
+// Первый обработчик в списке вызывается первым.
+func chain(caller Caller, mws []Middleware) Caller {
+	for i := len(mws) - 1; i >= 0; i-- {
+		caller = mws[i](caller)
+	}
+	return caller
+}
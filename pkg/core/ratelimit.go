@@ -0,0 +1,232 @@
+package core
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/WORKHATERS/gote/pkg/types"
+)
+
+// RateLimiter интерфейс ограничителя частоты отправки сообщений.
+// Встроенная реализация - ChatLimiter; для нескольких процессов можно подключить распределённую.
+type RateLimiter interface {
+	// Wait блокирует выполнение, пока отправка в чат chatId не будет разрешена
+	Wait(ctx context.Context, chatId types.ChatID) error
+}
+
+// RateLimits структура ограничений частоты отправки сообщений
+type RateLimits struct {
+	// Минимальный интервал между сообщениями в один личный чат
+	PrivateInterval time.Duration
+
+	// Минимальный интервал между сообщениями в одну группу или канал
+	GroupInterval time.Duration
+
+	// Минимальный интервал между любыми сообщениями бота
+	GlobalInterval time.Duration
+}
+
+// DefaultRateLimits функция получения ограничений Telegram по умолчанию:
+// 1 сообщение в секунду в личный чат, 20 сообщений в минуту в группу и 30 сообщений в секунду всего
+func DefaultRateLimits() RateLimits {
+	return RateLimits{
+		PrivateInterval: time.Second,
+		GroupInterval:   time.Minute / 20,
+		GlobalInterval:  time.Second / 30,
+	}
+}
+
+// WithRateLimiter функция установки ограничителя частоты для методов send*, copy* и forward*
+func WithRateLimiter(l RateLimiter) Option {
+	return func(b *Bot) {
+		b.middlewares = append(b.middlewares, rateLimitMiddleware(l))
+	}
+}
+
+type chatSlot struct {
+	next    time.Time
+	waiting int
+}
+
+// ChatLimiter структура ограничителя частоты отправки сообщений по чатам в памяти процесса
+type ChatLimiter struct {
+	limits RateLimits
+
+	mu         sync.Mutex
+	globalNext time.Time
+	chats      map[types.ChatID]*chatSlot
+	waiting    int
+	lastPurge  time.Time
+}
+
+// NewChatLimiter функция-конструктор для ChatLimiter
+func NewChatLimiter(limits RateLimits) *ChatLimiter {
+	return &ChatLimiter{
+		limits: limits,
+		chats:  make(map[types.ChatID]*chatSlot),
+	}
+}
+
+// Wait метод ожидания разрешения на отправку сообщения в чат
+func (l *ChatLimiter) Wait(ctx context.Context, chatId types.ChatID) error {
+	l.mu.Lock()
+	now := time.Now()
+	l.purge(now)
+
+	slot, ok := l.chats[chatId]
+	if !ok {
+		slot = &chatSlot{}
+		l.chats[chatId] = slot
+	}
+
+	// слоты чата и бота занимаются независимо: ожидание очереди одного чата
+	// не должно задерживать отправку в другие чаты
+	chatAt := now
+	if slot.next.After(chatAt) {
+		chatAt = slot.next
+	}
+	globalAt := now
+	if l.globalNext.After(globalAt) {
+		globalAt = l.globalNext
+	}
+	at := chatAt
+	if globalAt.After(at) {
+		at = globalAt
+	}
+
+	prevNext, prevGlobal := slot.next, l.globalNext
+	slot.next = at.Add(l.interval(chatId))
+	l.globalNext = globalAt.Add(l.limits.GlobalInterval)
+	reserved, reservedGlobal := slot.next, l.globalNext
+	slot.waiting++
+	l.waiting++
+	l.mu.Unlock()
+
+	defer func() {
+		l.mu.Lock()
+		slot.waiting--
+		l.waiting--
+		l.mu.Unlock()
+	}()
+
+	delay := time.Until(at)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// отменённое ожидание возвращает свой интервал, если после него никто не занял очередь
+		l.mu.Lock()
+		if slot.next.Equal(reserved) {
+			slot.next = prevNext
+		}
+		if l.globalNext.Equal(reservedGlobal) {
+			l.globalNext = prevGlobal
+		}
+		l.mu.Unlock()
+
+		return ctx.Err()
+	}
+}
+
+// QueueDepth метод получения количества сообщений, ожидающих отправки в чат
+func (l *ChatLimiter) QueueDepth(chatId types.ChatID) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if slot, ok := l.chats[chatId]; ok {
+		return slot.waiting
+	}
+	return 0
+}
+
+// TotalQueueDepth метод получения количества сообщений, ожидающих отправки во все чаты
+func (l *ChatLimiter) TotalQueueDepth() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.waiting
+}
+
+// interval метод получения интервала между сообщениями для чата.
+// Отрицательные id и имена пользователей принадлежат группам и каналам.
+func (l *ChatLimiter) interval(chatId types.ChatID) time.Duration {
+	if id, ok := chatId.Int(); ok && id > 0 {
+		return l.limits.PrivateInterval
+	}
+	return l.limits.GroupInterval
+}
+
+// purge метод удаления неактивных чатов не чаще раза в минуту
+func (l *ChatLimiter) purge(now time.Time) {
+	if now.Sub(l.lastPurge) < time.Minute {
+		return
+	}
+	l.lastPurge = now
+
+	for id, slot := range l.chats {
+		if slot.waiting == 0 && slot.next.Before(now) {
+			delete(l.chats, id)
+		}
+	}
+}
+
+// rateLimitMiddleware функция создания промежуточного обработчика, ограничивающего частоту отправки
+func rateLimitMiddleware(l RateLimiter) Middleware {
+	return func(next Caller) Caller {
+		return CallerFunc(func(ctx context.Context, method string, params any, result any) error {
+			if isSendMethod(method) {
+				if chatId, ok := paramsChatID(params); ok {
+					if err := l.Wait(ctx, chatId); err != nil {
+						return err
+					}
+				}
+			}
+
+			return next.Call(ctx, method, params, result)
+		})
+	}
+}
+
+// isSendMethod функция проверки, отправляет ли метод сообщения в чат.
+// Имена методов Bot API не зависят от регистра.
+func isSendMethod(method string) bool {
+	method = strings.ToLower(method)
+	if method == "sendchataction" {
+		return false
+	}
+	return strings.HasPrefix(method, "send") ||
+		strings.HasPrefix(method, "copy") ||
+		strings.HasPrefix(method, "forward")
+}
+
+// paramsChatID функция получения значения поля ChatId из параметров метода
+func paramsChatID(params any) (types.ChatID, bool) {
+	v := reflect.Indirect(reflect.ValueOf(params))
+	if v.Kind() != reflect.Struct {
+		return "", false
+	}
+
+	field := v.FieldByName("ChatId")
+	if !field.IsValid() || field.IsZero() {
+		return "", false
+	}
+
+	switch id := field.Interface().(type) {
+	case types.ChatID:
+		return id, true
+	case int64:
+		return types.ChatIDInt(id), true
+	}
+
+	return "", false
+}
@@ -0,0 +1,66 @@
+package core_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/WORKHATERS/gote/pkg/core"
+	"github.com/WORKHATERS/gote/pkg/types"
+)
+
+func TestChatLimiterCancelledWaitReleasesSlot(t *testing.T) {
+	l := core.NewChatLimiter(core.RateLimits{PrivateInterval: 200 * time.Millisecond})
+	chat := types.ChatIDInt(5)
+
+	if err := l.Wait(context.Background(), chat); err != nil {
+		t.Fatal(err)
+	}
+
+	for range 5 {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		if err := l.Wait(ctx, chat); err == nil {
+			t.Fatal("ожидание должно быть прервано контекстом")
+		}
+		cancel()
+	}
+
+	start := time.Now()
+	if err := l.Wait(context.Background(), chat); err != nil {
+		t.Fatal(err)
+	}
+	if d := time.Since(start); d > 300*time.Millisecond {
+		t.Fatalf("отправка отложена на %v, отменённые ожидания не вернули интервал", d)
+	}
+}
+
+func TestChatLimiterChatsDoNotBlockEachOther(t *testing.T) {
+	l := core.NewChatLimiter(core.DefaultRateLimits())
+	ctx := context.Background()
+
+	// два сообщения в чат 1: второе ждёт интервал личного чата
+	if err := l.Wait(ctx, types.ChatIDInt(1)); err != nil {
+		t.Fatal(err)
+	}
+
+	second := make(chan time.Duration, 1)
+	go func() {
+		start := time.Now()
+		_ = l.Wait(ctx, types.ChatIDInt(1))
+		second <- time.Since(start)
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	// отправка в чат 2 ждёт только общий интервал бота
+	start := time.Now()
+	if err := l.Wait(ctx, types.ChatIDInt(2)); err != nil {
+		t.Fatal(err)
+	}
+	if d := time.Since(start); d > 200*time.Millisecond {
+		t.Fatalf("отправка в чат 2 ждала %v из-за очереди чата 1", d)
+	}
+
+	if d := <-second; d < 900*time.Millisecond {
+		t.Fatalf("второе сообщение в чат 1 отправлено через %v, ожидался интервал 1s", d)
+	}
+}
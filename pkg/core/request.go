@@ -0,0 +1,312 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/WORKHATERS/gote/pkg/types"
+)
+
+var inputFileType = reflect.TypeOf((*types.InputFile)(nil))
+
+// tgResponse структура ответа Telegram Bot API
+type tgResponse struct {
+	Ok          bool                      `json:"ok"`
+	Result      json.RawMessage           `json:"result"`
+	Description string                    `json:"description,omitempty"`
+	ErrorCode   int                       `json:"error_code,omitempty"`
+	Parameters  *types.ResponseParameters `json:"parameters,omitempty"`
+}
+
+// CallAs функция вызова произвольного метода Telegram Bot API с декодированием результата в T.
+// Через неё выполняются все сгенерированные методы; её же можно использовать
+// для методов, которые ещё не попали в генератор.
+func CallAs[T any](ctx context.Context, bot *Bot, method string, params any) (T, error) {
+	var result T
+	if err := bot.Call(ctx, method, params, &result); err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return result, nil
+}
+
+// execute метод выполнения HTTP-запроса к методу Telegram Bot API.
+// Поле result ответа декодируется в result, который должен быть указателем.
+func (bot *Bot) execute(ctx context.Context, method string, param any, result any) (err error) {
+	var status int
+	if bot.debug {
+		start := time.Now()
+		defer func() { bot.logCall(method, param, status, time.Since(start), err) }()
+	}
+
+	req, err := bot.newRequest(ctx, method, param)
+	if err != nil {
+		return err
+	}
+
+	resp, err := bot.client.Do(req)
+	if err != nil {
+		// клиент мог не закрыть тело: без этого горутина записи multipart заблокируется навсегда
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return bot.redactError(err)
+	}
+	defer resp.Body.Close()
+	status = resp.StatusCode
+
+	response, err := bot.readResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	if !response.Ok {
+		return newAPIError(response.ErrorCode, response.Description, response.Parameters)
+	}
+
+	if result == nil || len(response.Result) == 0 {
+		return nil
+	}
+
+	return types.Unmarshal(response.Result, result)
+}
+
+// readResponse метод чтения ответа Telegram Bot API с ограничением размера.
+// Если тело не удалось разобрать и HTTP-статус неуспешный (например, 502 от прокси),
+// возвращается APIError с кодом HTTP-статуса.
+func (bot *Bot) readResponse(resp *http.Response) (*tgResponse, error) {
+	data, err := io.ReadAll(io.LimitReader(resp.Body, bot.maxResponseSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(data)) > bot.maxResponseSize {
+		return nil, fmt.Errorf("размер ответа превышает %d байт", bot.maxResponseSize)
+	}
+
+	var response tgResponse
+	if err := json.Unmarshal(data, &response); err != nil || (!response.Ok && response.ErrorCode == 0) {
+		if resp.StatusCode != http.StatusOK {
+			return nil, newAPIError(resp.StatusCode, http.StatusText(resp.StatusCode), nil)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("некорректный ответ Telegram: %w", err)
+		}
+	}
+
+	return &response, nil
+}
+
+// newRequest метод создания HTTP-запроса к методу Telegram Bot API.
+// Если параметры содержат файлы для загрузки, тело отправляется как multipart/form-data,
+// иначе как JSON.
+func (bot *Bot) newRequest(ctx context.Context, method string, param any) (*http.Request, error) {
+	url := bot.MethodURL(method)
+
+	var uploads []*types.InputFile
+	collectUploads(reflect.ValueOf(param), &uploads)
+
+	if len(uploads) == 0 {
+		if param == nil {
+			param = struct{}{}
+		}
+
+		data, err := json.Marshal(param)
+		if err != nil {
+			return nil, err
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+		if err != nil {
+			return nil, bot.redactError(err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		return req, nil
+	}
+
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, pr)
+	if err != nil {
+		return nil, bot.redactError(err)
+	}
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+
+	go func() {
+		err := writeMultipart(mw, reflect.ValueOf(param))
+		if err == nil {
+			err = mw.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	return req, nil
+}
+
+// writeMultipart функция записи параметров метода в тело multipart/form-data
+func writeMultipart(mw *multipart.Writer, v reflect.Value) error {
+	v = reflect.Indirect(v)
+
+	// файлы верхнего уровня отправляются под именем поля,
+	// вложенные - под своим именем вложения (attach://<имя>)
+	written := make(map[*types.InputFile]bool)
+
+	writeField := func(name string, field reflect.Value) error {
+		if file, ok := field.Interface().(*types.InputFile); ok && file.IsUpload() {
+			written[file] = true
+			return writeFilePart(mw, name, file)
+		}
+
+		value, err := formValue(field.Interface())
+		if err != nil {
+			return err
+		}
+		return mw.WriteField(name, value)
+	}
+
+	switch {
+	case v.Kind() == reflect.Struct:
+		t := v.Type()
+		for i := range t.NumField() {
+			field := v.Field(i)
+			if !t.Field(i).IsExported() || field.IsZero() {
+				continue
+			}
+
+			name := jsonFieldName(t.Field(i))
+			if name == "" {
+				continue
+			}
+
+			if err := writeField(name, field); err != nil {
+				return err
+			}
+		}
+	case v.Kind() == reflect.Map && v.Type().Key().Kind() == reflect.String:
+		iter := v.MapRange()
+		for iter.Next() {
+			field := iter.Value()
+			if !field.IsValid() || field.IsZero() {
+				continue
+			}
+
+			if err := writeField(iter.Key().String(), field); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("параметры должны быть структурой или map со строковыми ключами, получено %s", v.Kind())
+	}
+
+	var uploads []*types.InputFile
+	collectUploads(v, &uploads)
+	for _, file := range uploads {
+		if written[file] {
+			continue
+		}
+		written[file] = true
+		if err := writeFilePart(mw, file.AttachName(), file); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeFilePart функция записи содержимого файла в часть multipart/form-data
+func writeFilePart(mw *multipart.Writer, name string, file *types.InputFile) error {
+	r, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	w, err := mw.CreateFormFile(name, file.Name())
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(w, r)
+	return err
+}
+
+// formValue функция получения строкового значения поля формы.
+// Строки передаются как есть, остальные значения - в виде JSON.
+func formValue(v any) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return "", err
+		}
+		return s, nil
+	}
+
+	return string(data), nil
+}
+
+// jsonFieldName функция получения имени поля из JSON-тега
+func jsonFieldName(f reflect.StructField) string {
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		return ""
+	}
+
+	name, _, _ := strings.Cut(tag, ",")
+	if name == "" {
+		return f.Name
+	}
+
+	return name
+}
+
+// collectUploads функция поиска всех файлов для загрузки в параметрах метода
+func collectUploads(v reflect.Value, uploads *[]*types.InputFile) {
+	if !v.IsValid() {
+		return
+	}
+
+	if v.Type() == inputFileType {
+		if file := v.Interface().(*types.InputFile); file.IsUpload() {
+			*uploads = append(*uploads, file)
+		}
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			collectUploads(v.Elem(), uploads)
+		}
+	case reflect.Struct:
+		for i := range v.NumField() {
+			if v.Type().Field(i).IsExported() {
+				collectUploads(v.Field(i), uploads)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := range v.Len() {
+			collectUploads(v.Index(i), uploads)
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			collectUploads(iter.Value(), uploads)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package core_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/WORKHATERS/gote/pkg/core"
+	"github.com/WORKHATERS/gote/pkg/types"
+)
+
+// failingClient HTTP-клиент, который возвращает ошибку, не читая и не закрывая тело запроса
+type failingClient struct {
+	req *http.Request
+}
+
+func (c *failingClient) Do(req *http.Request) (*http.Response, error) {
+	c.req = req
+	return nil, errors.New("соединение сброшено")
+}
+
+func TestMultipartBodyClosedOnClientError(t *testing.T) {
+	client := &failingClient{}
+	bot := core.NewBot(context.Background(), "123:TOKEN", core.WithHTTPClient(client))
+	defer bot.Stop()
+
+	_, err := bot.SendDocument(context.Background(), types.SendDocument{
+		ChatId:   types.ChatIDInt(5),
+		Document: types.InputFileReader("big.bin", strings.NewReader(strings.Repeat("x", 1<<20))),
+	})
+	if err == nil {
+		t.Fatal("ожидалась ошибка клиента")
+	}
+
+	// закрытое тело освобождает горутину, записывающую multipart
+	if _, err := client.req.Body.Read(make([]byte, 1)); !errors.Is(err, io.ErrClosedPipe) {
+		t.Fatalf("тело запроса не закрыто: чтение вернуло %v", err)
+	}
+}
+
+func TestRequestErrorsRedactToken(t *testing.T) {
+	const token = "123456:SECRET-TOKEN"
+
+	bot := core.NewBot(context.Background(), token,
+		core.WithAPIEndpoint("http://api.example.com/\x7f"),
+		core.WithFileEndpoint("http://files.example.com/\x7f"),
+	)
+	defer bot.Stop()
+
+	_, err := bot.GetMe(context.Background(), types.GetMe{})
+	if err == nil {
+		t.Fatal("ожидалась ошибка разбора адреса")
+	}
+	if strings.Contains(err.Error(), token) {
+		t.Fatalf("ошибка запроса содержит токен: %v", err)
+	}
+
+	_, err = bot.OpenTelegramFile(context.Background(), &types.File{FileId: "f", FilePath: "photos/1.jpg"})
+	if err == nil {
+		t.Fatal("ожидалась ошибка разбора адреса")
+	}
+	if strings.Contains(err.Error(), token) {
+		t.Fatalf("ошибка скачивания содержит токен: %v", err)
+	}
+}
@@ -0,0 +1,182 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/WORKHATERS/gote/pkg/types"
+)
+
+// nonIdempotentPrefixes префиксы методов, повторный вызов которых может привести к дублированию
+// (например, повторной отправке сообщения), если первый запрос дошёл до Telegram
+var nonIdempotentPrefixes = []string{
+	"send", "forward", "copy", "create", "post", "upload", "add",
+	"transfer", "gift", "convert", "upgrade", "refund", "savePrepared",
+}
+
+// RetryPolicy структура политики повторных вызовов методов API
+type RetryPolicy struct {
+	// Максимальное количество попыток, включая первую
+	MaxAttempts int
+
+	// Начальная задержка экспоненциального backoff
+	MinDelay time.Duration
+
+	// Максимальная задержка экспоненциального backoff
+	MaxDelay time.Duration
+}
+
+// DefaultRetryPolicy функция получения политики повторов по умолчанию
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 5,
+		MinDelay:    500 * time.Millisecond,
+		MaxDelay:    30 * time.Second,
+	}
+}
+
+// WithRetry функция включения повторных вызовов методов API по указанной политике
+func WithRetry(p RetryPolicy) Option {
+	return func(b *Bot) {
+		b.retry = &p
+		b.middlewares = append(b.middlewares, retryMiddleware(p))
+	}
+}
+
+// RetryPolicy метод получения политики повторов бота; false, если повторы не включены
+func (b *Bot) RetryPolicy() (RetryPolicy, bool) {
+	if b.retry == nil {
+		return RetryPolicy{}, false
+	}
+	return *b.retry, true
+}
+
+// Delay метод вычисления задержки перед повтором номер attempt (начиная с 1) после ошибки err.
+// Если Telegram вернул retry_after, используется это значение,
+// иначе - экспоненциальная задержка со случайным разбросом.
+func (p RetryPolicy) Delay(err error, attempt int) time.Duration {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.RetryAfter() > 0 {
+		return apiErr.RetryAfter()
+	}
+
+	delay := p.MinDelay
+	for i := 1; i < attempt && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+
+	// задержка выбирается случайно из диапазона [delay/2, delay]
+	return delay/2 + rand.N(delay/2+1)
+}
+
+// Retryable метод проверки, можно ли повторить вызов метода method после ошибки err
+func (p RetryPolicy) Retryable(method string, err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		// запрос отклонён Telegram до выполнения
+		if apiErr.Code == 429 {
+			return true
+		}
+		// ошибка на стороне Telegram: запрос мог быть выполнен
+		if apiErr.Code >= 500 {
+			return isIdempotent(method)
+		}
+		return false
+	}
+
+	// соединение не было установлено - запрос точно не дошёл до Telegram
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+
+	// сетевые ошибки после отправки запроса (таймаут, обрыв соединения)
+	var netErr net.Error
+	if errors.As(err, &netErr) || errors.Is(err, context.DeadlineExceeded) {
+		return isIdempotent(method)
+	}
+
+	return false
+}
+
+// isIdempotent функция проверки, безопасен ли повторный вызов метода.
+// Имена методов Bot API не зависят от регистра.
+func isIdempotent(method string) bool {
+	method = strings.ToLower(method)
+	for _, prefix := range nonIdempotentPrefixes {
+		if strings.HasPrefix(method, strings.ToLower(prefix)) {
+			return false
+		}
+	}
+	return true
+}
+
+type noRetryKey struct{}
+
+// WithoutRetry функция получения контекста, в котором вызовы API не повторяются политикой бота (WithRetry).
+// Нужна, когда вызывающий сам управляет повторами, как Poller для getUpdates.
+func WithoutRetry(ctx context.Context) context.Context {
+	return context.WithValue(ctx, noRetryKey{}, true)
+}
+
+// retryMiddleware функция создания промежуточного обработчика повторных вызовов
+func retryMiddleware(p RetryPolicy) Middleware {
+	return func(next Caller) Caller {
+		return CallerFunc(func(ctx context.Context, method string, params any, result any) error {
+			if ctx.Value(noRetryKey{}) != nil {
+				return next.Call(ctx, method, params, result)
+			}
+
+			for attempt := 1; ; attempt++ {
+				err := next.Call(ctx, method, params, result)
+				if attempt >= p.MaxAttempts || !p.Retryable(method, err) || hasStreamUpload(params) {
+					return err
+				}
+
+				delay := p.Delay(err, attempt)
+				if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+					return err
+				}
+
+				select {
+				case <-time.After(delay):
+				case <-ctx.Done():
+					return err
+				}
+			}
+		})
+	}
+}
+
+// hasStreamUpload функция проверки наличия файлов из io.Reader, которые нельзя отправить повторно
+func hasStreamUpload(params any) bool {
+	var uploads []*types.InputFile
+	collectUploads(reflect.ValueOf(params), &uploads)
+
+	for _, f := range uploads {
+		if f.IsStream() {
+			return true
+		}
+	}
+
+	return false
+}
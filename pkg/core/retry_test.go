@@ -0,0 +1,33 @@
+package core
+
+import "testing"
+
+func TestMethodClassification(t *testing.T) {
+	tests := []struct {
+		method     string
+		idempotent bool
+		send       bool
+	}{
+		{"sendMessage", false, true},
+		{"SendMessage", false, true},
+		{"SENDPHOTO", false, true},
+		{"copyMessage", false, true},
+		{"ForwardMessage", false, true},
+		{"sendChatAction", false, false},
+		{"SendChatAction", false, false},
+		{"savePreparedInlineMessage", false, false},
+		{"SavePreparedInlineMessage", false, false},
+		{"getMe", true, false},
+		{"GetUpdates", true, false},
+		{"editMessageText", true, false},
+	}
+
+	for _, tt := range tests {
+		if got := isIdempotent(tt.method); got != tt.idempotent {
+			t.Errorf("isIdempotent(%q) = %v, ожидалось %v", tt.method, got, tt.idempotent)
+		}
+		if got := isSendMethod(tt.method); got != tt.send {
+			t.Errorf("isSendMethod(%q) = %v, ожидалось %v", tt.method, got, tt.send)
+		}
+	}
+}
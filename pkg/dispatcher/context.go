@@ -0,0 +1,218 @@
+package dispatcher
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"github.com/WORKHATERS/gote/pkg/core"
+	"github.com/WORKHATERS/gote/pkg/types"
+)
+
+// Context структура контекста обработки одного обновления
+type Context struct {
+	context.Context
+
+	// Бот, получивший обновление
+	Bot *core.Bot
+
+	// Обрабатываемое обновление
+	Update types.Update
+
+	kind     Kind
+	username func() string
+}
+
+func newContext(ctx context.Context, b *core.Bot, u types.Update) *Context {
+	return &Context{
+		Context: ctx,
+		Bot:     b,
+		Update:  u,
+		kind:    KindOf(u),
+	}
+}
+
+// Kind метод получения типа обновления
+func (c *Context) Kind() Kind { return c.kind }
+
+// Message метод получения сообщения из обновления:
+// новое или отредактированное сообщение, пост канала, бизнес-сообщение
+// или доступное сообщение, к которому привязан callback-запрос
+func (c *Context) Message() *types.Message {
+	u := c.Update
+
+	switch {
+	case u.Message != nil:
+		return u.Message
+	case u.EditedMessage != nil:
+		return u.EditedMessage
+	case u.ChannelPost != nil:
+		return u.ChannelPost
+	case u.EditedChannelPost != nil:
+		return u.EditedChannelPost
+	case u.BusinessMessage != nil:
+		return u.BusinessMessage
+	case u.EditedBusinessMessage != nil:
+		return u.EditedBusinessMessage
+	case u.CallbackQuery != nil:
+		if m, ok := u.CallbackQuery.Message.(*types.Message); ok {
+			return m
+		}
+	}
+
+	return nil
+}
+
+// Chat метод получения чата, в котором произошло обновление
+func (c *Context) Chat() *types.Chat {
+	if m := c.Message(); m != nil {
+		return m.Chat
+	}
+
+	u := c.Update
+
+	switch {
+	case u.CallbackQuery != nil:
+		if m, ok := u.CallbackQuery.Message.(*types.InaccessibleMessage); ok {
+			return m.Chat
+		}
+	case u.DeletedBusinessMessages != nil:
+		return u.DeletedBusinessMessages.Chat
+	case u.MessageReaction != nil:
+		return u.MessageReaction.Chat
+	case u.MessageReactionCount != nil:
+		return u.MessageReactionCount.Chat
+	case u.MyChatMember != nil:
+		return u.MyChatMember.Chat
+	case u.ChatMember != nil:
+		return u.ChatMember.Chat
+	case u.ChatJoinRequest != nil:
+		return u.ChatJoinRequest.Chat
+	case u.ChatBoost != nil:
+		return u.ChatBoost.Chat
+	case u.RemovedChatBoost != nil:
+		return u.RemovedChatBoost.Chat
+	}
+
+	return nil
+}
+
+// Sender метод получения пользователя, инициировавшего обновление
+func (c *Context) Sender() *types.User {
+	u := c.Update
+
+	switch {
+	case u.CallbackQuery != nil:
+		return u.CallbackQuery.From
+	case u.InlineQuery != nil:
+		return u.InlineQuery.From
+	case u.ChosenInlineResult != nil:
+		return u.ChosenInlineResult.From
+	case u.ShippingQuery != nil:
+		return u.ShippingQuery.From
+	case u.PreCheckoutQuery != nil:
+		return u.PreCheckoutQuery.From
+	case u.PurchasedPaidMedia != nil:
+		return u.PurchasedPaidMedia.From
+	case u.PollAnswer != nil:
+		return u.PollAnswer.User
+	case u.MyChatMember != nil:
+		return u.MyChatMember.From
+	case u.ChatMember != nil:
+		return u.ChatMember.From
+	case u.ChatJoinRequest != nil:
+		return u.ChatJoinRequest.From
+	case u.MessageReaction != nil:
+		return u.MessageReaction.User
+	case u.BusinessConnection != nil:
+		return u.BusinessConnection.User
+	}
+
+	if m := c.Message(); m != nil {
+		return m.From
+	}
+
+	return nil
+}
+
+// Text метод получения текста обновления: текст или подпись сообщения,
+// данные callback-запроса или текст inline-запроса
+func (c *Context) Text() string {
+	u := c.Update
+
+	switch {
+	case u.CallbackQuery != nil:
+		return u.CallbackQuery.Data
+	case u.InlineQuery != nil:
+		return u.InlineQuery.Query
+	case u.ChosenInlineResult != nil:
+		return u.ChosenInlineResult.Query
+	}
+
+	if m := c.Message(); m != nil {
+		if m.Text != "" {
+			return m.Text
+		}
+		return m.Caption
+	}
+
+	return ""
+}
+
+// Command метод получения команды из текста сообщения без символа "/" и имени бота.
+// Команды, адресованные другому боту (/start@OtherBot), не учитываются.
+func (c *Context) Command() string {
+	m := c.Message()
+	if m == nil || !strings.HasPrefix(m.Text, "/") {
+		return ""
+	}
+
+	fields := strings.Fields(m.Text[1:])
+	if len(fields) == 0 {
+		return ""
+	}
+	command, mention, _ := strings.Cut(fields[0], "@")
+	if mention != "" && c.username != nil {
+		if name := c.username(); name != "" && !strings.EqualFold(mention, name) {
+			return ""
+		}
+	}
+
+	return command
+}
+
+// CommandArgs метод получения аргументов команды - текста после неё
+func (c *Context) CommandArgs() string {
+	if c.Command() == "" {
+		return ""
+	}
+
+	text := strings.TrimSpace(c.Message().Text)
+	i := strings.IndexFunc(text, unicode.IsSpace)
+	if i == -1 {
+		return ""
+	}
+
+	return strings.TrimSpace(text[i:])
+}
+
+// Reply метод отправки текстового ответа в чат, в котором произошло обновление
+func (c *Context) Reply(text string) (*types.Message, error) {
+	chat := c.Chat()
+	if chat == nil {
+		return nil, ErrNoChat
+	}
+
+	param := types.SendMessage{
+		ChatId: types.ChatIDInt(chat.Id),
+		Text:   text,
+	}
+	if m := c.Message(); m != nil {
+		if m.IsTopicMessage {
+			param.MessageThreadId = m.MessageThreadId
+		}
+		param.BusinessConnectionId = m.BusinessConnectionId
+	}
+
+	return c.Bot.SendMessage(c, param)
+}
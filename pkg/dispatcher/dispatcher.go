@@ -0,0 +1,275 @@
+package dispatcher
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"sync"
+
+	"github.com/WORKHATERS/gote/pkg/core"
+	"github.com/WORKHATERS/gote/pkg/types"
+	"github.com/WORKHATERS/gote/pkg/updater"
+)
+
+// ErrNoChat ошибка отсутствия чата в обновлении
+var ErrNoChat = errors.New("обновление не содержит чат")
+
+// Handler тип обработчика обновления
+type Handler func(c *Context) error
+
+// ErrorHandler тип обработчика ошибок, возвращённых обработчиками обновлений
+type ErrorHandler func(c *Context, err error)
+
+type route struct {
+	kind    Kind
+	filter  Filter
+	handler Handler
+}
+
+// Dispatcher структура для маршрутизации обновлений по обработчикам.
+// Обновление передаётся первому зарегистрированному обработчику, тип и фильтры которого ему подходят.
+type Dispatcher struct {
+	bot          *core.Bot
+	routes       []route
+	middlewares  []Middleware
+	errorHandler ErrorHandler
+
+	mu        sync.Mutex
+	usernames map[*core.Bot]string
+}
+
+// Option тип функциональных параметров
+type Option func(*Dispatcher)
+
+// NewDispatcher функция-конструктор для Dispatcher
+func NewDispatcher(b *core.Bot, opts ...Option) *Dispatcher {
+	d := &Dispatcher{
+		bot:       b,
+		usernames: make(map[*core.Bot]string),
+	}
+
+	d.errorHandler = func(c *Context, err error) {
+		d.logger(c.Bot).Error("Ошибка обработки обновления", "update_id", c.Update.UpdateId, "error", err)
+	}
+
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
+}
+
+// WithErrorHandler функция установки обработчика ошибок
+func WithErrorHandler(h ErrorHandler) Option {
+	return func(d *Dispatcher) { d.errorHandler = h }
+}
+
+// Handle метод регистрации обработчика для указанного типа обновлений.
+// Пустой тип означает любое обновление.
+func (d *Dispatcher) Handle(kind Kind, h Handler, filters ...Filter) {
+	d.routes = append(d.routes, route{
+		kind:    kind,
+		filter:  And(filters...),
+		handler: h,
+	})
+}
+
+// OnAny метод регистрации обработчика для обновлений любого типа
+func (d *Dispatcher) OnAny(h Handler, filters ...Filter) {
+	d.Handle("", h, filters...)
+}
+
+// OnMessage метод регистрации обработчика новых сообщений
+func (d *Dispatcher) OnMessage(h Handler, filters ...Filter) {
+	d.Handle(KindMessage, h, filters...)
+}
+
+// OnEditedMessage метод регистрации обработчика отредактированных сообщений
+func (d *Dispatcher) OnEditedMessage(h Handler, filters ...Filter) {
+	d.Handle(KindEditedMessage, h, filters...)
+}
+
+// OnChannelPost метод регистрации обработчика постов канала
+func (d *Dispatcher) OnChannelPost(h Handler, filters ...Filter) {
+	d.Handle(KindChannelPost, h, filters...)
+}
+
+// OnCommand метод регистрации обработчика команды (без символа "/")
+func (d *Dispatcher) OnCommand(command string, h Handler, filters ...Filter) {
+	d.Handle(KindMessage, h, append([]Filter{Command(command)}, filters...)...)
+}
+
+// OnCallbackQuery метод регистрации обработчика callback-запросов
+func (d *Dispatcher) OnCallbackQuery(h Handler, filters ...Filter) {
+	d.Handle(KindCallbackQuery, h, filters...)
+}
+
+// OnInlineQuery метод регистрации обработчика inline-запросов
+func (d *Dispatcher) OnInlineQuery(h Handler, filters ...Filter) {
+	d.Handle(KindInlineQuery, h, filters...)
+}
+
+// OnChatMember метод регистрации обработчика изменений статуса участников чата
+func (d *Dispatcher) OnChatMember(h Handler, filters ...Filter) {
+	d.Handle(KindChatMember, h, filters...)
+}
+
+// OnMyChatMember метод регистрации обработчика изменений статуса бота в чате
+func (d *Dispatcher) OnMyChatMember(h Handler, filters ...Filter) {
+	d.Handle(KindMyChatMember, h, filters...)
+}
+
+// Run метод обработки обновлений из Updater до закрытия канала или отмены контекста.
+// Если Updater поддерживает подтверждение (Acknowledger), каждое обновление подтверждается после обработки.
+// Если Updater остановился из-за ошибки (метод Err), она возвращается.
+func (d *Dispatcher) Run(ctx context.Context, u updater.Updater) error {
+	updates := u.Start()
+	updater.WarnUndelivered(d.logger(d.bot), d.HandlerKinds(), u)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case update, ok := <-updates:
+			if !ok {
+				if e, ok := u.(interface{ Err() error }); ok {
+					return e.Err()
+				}
+				return nil
+			}
+			d.ProcessUpdate(ctx, update)
+			if ack, ok := u.(updater.Acknowledger); ok {
+				ack.Ack(update.UpdateId)
+			}
+		}
+	}
+}
+
+// RunManager метод обработки объединённого потока обновлений нескольких ботов.
+// Обработчики отвечают через бота, получившего обновление. Возвращается после остановки Manager
+// или отмены контекста.
+func (d *Dispatcher) RunManager(ctx context.Context, m *updater.Manager) error {
+	updates := m.Start()
+
+	for _, name := range m.Names() {
+		b, _ := m.Bot(name)
+		if u, ok := m.Updater(name); ok {
+			updater.WarnUndelivered(d.logger(b), d.HandlerKinds(), u)
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case bu, ok := <-updates:
+			if !ok {
+				return nil
+			}
+			d.ProcessBotUpdate(ctx, bu.Bot, bu.Update)
+			bu.Ack()
+		}
+	}
+}
+
+// UpdateKinds метод получения типов обновлений, для которых зарегистрированы обработчики.
+// Если есть обработчик для любых обновлений, возвращаются все типы.
+// Подходит для updater.WithAllowedUpdatesFrom.
+func (d *Dispatcher) UpdateKinds() []types.UpdateKind {
+	var kinds []types.UpdateKind
+	for _, r := range d.routes {
+		if r.kind == "" {
+			return slices.Clone(types.AllUpdateKinds)
+		}
+		if !slices.Contains(kinds, r.kind) {
+			kinds = append(kinds, r.kind)
+		}
+	}
+
+	return kinds
+}
+
+// HandlerKinds метод получения типов обновлений, для которых явно зарегистрированы обработчики,
+// без обработчиков любых обновлений. Используется для предупреждений об обновлениях, которые не будут получены.
+func (d *Dispatcher) HandlerKinds() []types.UpdateKind {
+	var kinds []types.UpdateKind
+	for _, r := range d.routes {
+		if r.kind != "" && !slices.Contains(kinds, r.kind) {
+			kinds = append(kinds, r.kind)
+		}
+	}
+
+	return kinds
+}
+
+// logger метод получения логгера бота или логгера по умолчанию, если бот не задан
+func (d *Dispatcher) logger(b *core.Bot) core.Logger {
+	if b == nil {
+		return slog.Default()
+	}
+	return b.Logger()
+}
+
+// botUsername метод получения имени бота для проверки адресата команд.
+// Имя запрашивается через getMe один раз для каждого бота; при ошибке возвращается пустая строка
+// и запрос повторяется при следующей команде с именем бота.
+func (d *Dispatcher) botUsername(ctx context.Context, b *core.Bot) string {
+	if b == nil {
+		return ""
+	}
+
+	d.mu.Lock()
+	name, ok := d.usernames[b]
+	d.mu.Unlock()
+	if ok {
+		return name
+	}
+
+	me, err := b.GetMe(ctx, types.GetMe{})
+	if err != nil {
+		b.Logger().Warn("Не удалось получить имя бота для проверки адресата команды", "error", err)
+		return ""
+	}
+
+	d.mu.Lock()
+	d.usernames[b] = me.Username
+	d.mu.Unlock()
+
+	return me.Username
+}
+
+// ProcessUpdate метод обработки одного обновления.
+// Возвращает false, если подходящий обработчик не найден.
+func (d *Dispatcher) ProcessUpdate(ctx context.Context, u types.Update) bool {
+	return d.ProcessBotUpdate(ctx, d.bot, u)
+}
+
+// ProcessBotUpdate метод обработки обновления, полученного другим ботом.
+// Позволяет использовать одни обработчики для нескольких ботов: Context.Bot будет равен b.
+func (d *Dispatcher) ProcessBotUpdate(ctx context.Context, b *core.Bot, u types.Update) bool {
+	c := newContext(ctx, b, u)
+	c.username = func() string { return d.botUsername(ctx, b) }
+
+	for _, r := range d.routes {
+		if r.kind != "" && r.kind != c.kind {
+			continue
+		}
+		if !r.filter(c) {
+			continue
+		}
+
+		h := r.handler
+		for i := len(d.middlewares) - 1; i >= 0; i-- {
+			h = d.middlewares[i](h)
+		}
+
+		if err := h(c); err != nil && d.errorHandler != nil {
+			d.errorHandler(c, err)
+		}
+
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,117 @@
+package dispatcher_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/WORKHATERS/gote/pkg/core"
+	"github.com/WORKHATERS/gote/pkg/dispatcher"
+	"github.com/WORKHATERS/gote/pkg/gotetest"
+	"github.com/WORKHATERS/gote/pkg/types"
+	"github.com/WORKHATERS/gote/pkg/updater"
+)
+
+func TestHandlerErrorWithoutDispatcherBot(t *testing.T) {
+	s := gotetest.NewServer()
+	defer s.Close()
+
+	var buf bytes.Buffer
+	bot := s.Bot(context.Background(), core.WithLogger(slog.New(slog.NewTextHandler(&buf, nil))))
+	defer bot.Stop()
+
+	d := dispatcher.NewDispatcher(nil)
+	d.OnMessage(func(c *dispatcher.Context) error { return errors.New("сбой") })
+
+	u := types.Update{UpdateId: 1, Message: &types.Message{Chat: &types.Chat{Id: 5}, Text: "x"}}
+	if !d.ProcessBotUpdate(context.Background(), bot, u) {
+		t.Fatal("обработчик не найден")
+	}
+
+	if !strings.Contains(buf.String(), "сбой") {
+		t.Fatalf("ошибка не записана в лог бота, получившего обновление: %q", buf.String())
+	}
+}
+
+func TestWarnUndelivered(t *testing.T) {
+	const warning = "Обработчик зарегистрирован для типа обновлений"
+
+	tests := []struct {
+		name string
+		run  func(ctx context.Context, bot *core.Bot, d *dispatcher.Dispatcher)
+		want bool
+	}{
+		{"Poller без allowed_updates", func(ctx context.Context, bot *core.Bot, d *dispatcher.Dispatcher) {
+			d.Run(ctx, updater.NewPoller(bot, updater.WithTimeout(1)))
+		}, true},
+		{"Poller с allowed_updates без chat_member", func(ctx context.Context, bot *core.Bot, d *dispatcher.Dispatcher) {
+			d.Run(ctx, updater.NewPoller(bot, updater.WithTimeout(1), updater.WithAllowedUpdates([]string{"message"})))
+		}, true},
+		{"Poller с allowed_updates из обработчиков", func(ctx context.Context, bot *core.Bot, d *dispatcher.Dispatcher) {
+			d.Run(ctx, updater.NewPoller(bot, updater.WithTimeout(1), updater.WithAllowedUpdatesFrom(d)))
+		}, false},
+		{"WorkerPool", func(ctx context.Context, bot *core.Bot, d *dispatcher.Dispatcher) {
+			pool := updater.NewWorkerPool(bot, func(ctx context.Context, u types.Update) error {
+				d.ProcessUpdate(ctx, u)
+				return nil
+			}, updater.WithHandlerKinds(d))
+			pool.Run(ctx, updater.NewPoller(bot, updater.WithTimeout(1)))
+		}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := gotetest.NewServer()
+			defer s.Close()
+
+			var buf bytes.Buffer
+			bot := s.Bot(context.Background(), core.WithLogger(slog.New(slog.NewTextHandler(&buf, nil))))
+			defer bot.Stop()
+
+			d := dispatcher.NewDispatcher(bot)
+			d.OnMessage(func(c *dispatcher.Context) error { return nil })
+			d.OnChatMember(func(c *dispatcher.Context) error { return nil })
+
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			tt.run(ctx, bot, d)
+
+			if got := strings.Contains(buf.String(), warning); got != tt.want {
+				t.Fatalf("предупреждение: %v, ожидалось %v; лог: %s", got, tt.want, buf.String())
+			}
+			if tt.want && (!strings.Contains(buf.String(), "kind=chat_member") || strings.Contains(buf.String(), "kind=message ")) {
+				t.Fatalf("предупреждение не для chat_member: %s", buf.String())
+			}
+		})
+	}
+}
+
+func TestRunManagerWarnUndelivered(t *testing.T) {
+	s := gotetest.NewServer()
+	defer s.Close()
+
+	var buf bytes.Buffer
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	m := updater.NewManager(ctx, updater.WithBotOptions(
+		core.WithAPIEndpoint(s.URL()),
+		core.WithLogger(slog.New(slog.NewTextHandler(&buf, nil))),
+	))
+	if _, err := m.Add("shop", s.Token()); err != nil {
+		t.Fatal(err)
+	}
+
+	d := dispatcher.NewDispatcher(nil)
+	d.OnChatMember(func(c *dispatcher.Context) error { return nil })
+	d.RunManager(ctx, m)
+	m.Stop()
+
+	if !strings.Contains(buf.String(), "kind=chat_member") {
+		t.Fatalf("нет предупреждения для chat_member: %s", buf.String())
+	}
+}
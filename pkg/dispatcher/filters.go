@@ -0,0 +1,172 @@
+package dispatcher
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/WORKHATERS/gote/pkg/types"
+)
+
+// Filter тип фильтра, определяющего, подходит ли обновление обработчику
+type Filter func(c *Context) bool
+
+// Типы чатов
+const (
+	ChatPrivate    = "private"
+	ChatGroup      = "group"
+	ChatSupergroup = "supergroup"
+	ChatChannel    = "channel"
+)
+
+// Типы содержимого сообщения
+const (
+	ContentText      = "text"
+	ContentPhoto     = "photo"
+	ContentVideo     = "video"
+	ContentAnimation = "animation"
+	ContentAudio     = "audio"
+	ContentVoice     = "voice"
+	ContentVideoNote = "video_note"
+	ContentDocument  = "document"
+	ContentSticker   = "sticker"
+	ContentLocation  = "location"
+	ContentVenue     = "venue"
+	ContentContact   = "contact"
+	ContentPoll      = "poll"
+	ContentDice      = "dice"
+	ContentGame      = "game"
+	ContentInvoice   = "invoice"
+	ContentStory     = "story"
+	ContentPaidMedia = "paid_media"
+	ContentChecklist = "checklist"
+)
+
+// And функция объединения фильтров: обновление должно подходить под все фильтры
+func And(filters ...Filter) Filter {
+	return func(c *Context) bool {
+		for _, f := range filters {
+			if !f(c) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Or функция объединения фильтров: обновление должно подходить хотя бы под один фильтр
+func Or(filters ...Filter) Filter {
+	return func(c *Context) bool {
+		for _, f := range filters {
+			if f(c) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Not функция инверсии фильтра
+func Not(f Filter) Filter {
+	return func(c *Context) bool { return !f(c) }
+}
+
+// Command функция создания фильтра по командам сообщения (без символа "/").
+// Команды, адресованные другому боту (/start@OtherBot), не подходят.
+func Command(commands ...string) Filter {
+	return func(c *Context) bool {
+		command := c.Command()
+		return command != "" && slices.Contains(commands, command)
+	}
+}
+
+// Text функция создания фильтра по точному совпадению текста обновления
+func Text(texts ...string) Filter {
+	return func(c *Context) bool {
+		return slices.Contains(texts, c.Text())
+	}
+}
+
+// Regexp функция создания фильтра по регулярному выражению для текста обновления
+func Regexp(re *regexp.Regexp) Filter {
+	return func(c *Context) bool {
+		return re.MatchString(c.Text())
+	}
+}
+
+// Regex функция создания фильтра по регулярному выражению, заданному строкой.
+// Вызывает панику, если выражение некорректно.
+func Regex(pattern string) Filter {
+	return Regexp(regexp.MustCompile(pattern))
+}
+
+// ChatType функция создания фильтра по типу чата
+func ChatType(chatTypes ...string) Filter {
+	return func(c *Context) bool {
+		chat := c.Chat()
+		return chat != nil && slices.Contains(chatTypes, chat.Type)
+	}
+}
+
+// CallbackPrefix функция создания фильтра по префиксу данных callback-запроса
+func CallbackPrefix(prefix string) Filter {
+	return func(c *Context) bool {
+		cb := c.Update.CallbackQuery
+		return cb != nil && strings.HasPrefix(cb.Data, prefix)
+	}
+}
+
+// ContentType функция создания фильтра по типу содержимого сообщения
+func ContentType(contentTypes ...string) Filter {
+	return func(c *Context) bool {
+		m := c.Message()
+		return m != nil && slices.Contains(contentTypes, MessageContentType(m))
+	}
+}
+
+// MessageContentType функция определения типа содержимого сообщения.
+// Для служебных сообщений возвращает пустую строку.
+func MessageContentType(m *types.Message) string {
+	switch {
+	case m.Text != "":
+		return ContentText
+	case m.Photo != nil:
+		return ContentPhoto
+	case m.Video != nil:
+		return ContentVideo
+	case m.Animation != nil:
+		return ContentAnimation
+	case m.Audio != nil:
+		return ContentAudio
+	case m.Voice != nil:
+		return ContentVoice
+	case m.VideoNote != nil:
+		return ContentVideoNote
+	case m.Document != nil:
+		return ContentDocument
+	case m.Sticker != nil:
+		return ContentSticker
+	case m.Venue != nil:
+		return ContentVenue
+	case m.Location != nil:
+		return ContentLocation
+	case m.Contact != nil:
+		return ContentContact
+	case m.Poll != nil:
+		return ContentPoll
+	case m.Dice != nil:
+		return ContentDice
+	case m.Game != nil:
+		return ContentGame
+	case m.Invoice != nil:
+		return ContentInvoice
+	case m.Story != nil:
+		return ContentStory
+	case m.PaidMedia != nil:
+		return ContentPaidMedia
+	case m.Checklist != nil:
+		return ContentChecklist
+	}
+
+	return ""
+}
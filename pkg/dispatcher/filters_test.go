@@ -0,0 +1,184 @@
+package dispatcher_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/WORKHATERS/gote/pkg/core"
+	"github.com/WORKHATERS/gote/pkg/dispatcher"
+	"github.com/WORKHATERS/gote/pkg/gotetest"
+	"github.com/WORKHATERS/gote/pkg/types"
+)
+
+// newBot функция создания бота с именем GoteBot на фейковом сервере
+func newBot(t *testing.T) (*core.Bot, *gotetest.Server) {
+	t.Helper()
+
+	s := gotetest.NewServer(gotetest.WithMe(types.User{Id: 1, IsBot: true, FirstName: "Gote", Username: "GoteBot"}))
+	t.Cleanup(s.Close)
+
+	b := s.Bot(context.Background())
+	t.Cleanup(b.Stop)
+
+	return b, s
+}
+
+func message(chatType, text string) types.Update {
+	return types.Update{Message: &types.Message{Chat: &types.Chat{Id: 5, Type: chatType}, Text: text}}
+}
+
+func TestCommand(t *testing.T) {
+	bot, _ := newBot(t)
+
+	tests := []struct {
+		text        string
+		wantCommand string
+		wantArgs    string
+	}{
+		{"/start", "start", ""},
+		{"/start payload 42", "start", "payload 42"},
+		{"  /start", "", ""},
+		{"/start@GoteBot", "start", ""},
+		{"/start@gotebot  args ", "start", "args"},
+		{"/start@OtherBot args", "", ""},
+		{"/", "", ""},
+		{"start", "", ""},
+		{"", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			d := dispatcher.NewDispatcher(bot)
+
+			var command, args string
+			d.OnMessage(func(c *dispatcher.Context) error {
+				command, args = c.Command(), c.CommandArgs()
+				return nil
+			})
+
+			d.ProcessUpdate(context.Background(), message(dispatcher.ChatGroup, tt.text))
+
+			if command != tt.wantCommand || args != tt.wantArgs {
+				t.Fatalf("Command() = %q, CommandArgs() = %q, ожидалось %q, %q", command, args, tt.wantCommand, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestCommandAddressedToOtherBot(t *testing.T) {
+	bot, s := newBot(t)
+
+	d := dispatcher.NewDispatcher(bot)
+	handled := 0
+	d.OnCommand("start", func(c *dispatcher.Context) error {
+		handled++
+		return nil
+	})
+
+	for _, text := range []string{"/start@OtherBot", "/start@GoteBot", "/start@OtherBot", "/start"} {
+		d.ProcessUpdate(context.Background(), message(dispatcher.ChatGroup, text))
+	}
+
+	if handled != 2 {
+		t.Fatalf("обработано %d команд, ожидалось 2", handled)
+	}
+	if calls := s.CallsTo("getMe"); len(calls) != 1 {
+		t.Fatalf("getMe вызван %d раз, ожидался 1", len(calls))
+	}
+}
+
+func TestFilters(t *testing.T) {
+	bot, _ := newBot(t)
+
+	callback := func(data string) types.Update {
+		return types.Update{CallbackQuery: &types.CallbackQuery{Id: "1", Data: data}}
+	}
+	photo := types.Update{Message: &types.Message{
+		Chat:    &types.Chat{Id: 5, Type: dispatcher.ChatPrivate},
+		Photo:   []types.PhotoSize{{FileId: "p"}},
+		Caption: "подпись",
+	}}
+
+	tests := []struct {
+		name   string
+		filter dispatcher.Filter
+		update types.Update
+		want   bool
+	}{
+		{"ChatType совпадает", dispatcher.ChatType(dispatcher.ChatPrivate), message(dispatcher.ChatPrivate, "x"), true},
+		{"ChatType из нескольких", dispatcher.ChatType(dispatcher.ChatGroup, dispatcher.ChatSupergroup), message(dispatcher.ChatSupergroup, "x"), true},
+		{"ChatType не совпадает", dispatcher.ChatType(dispatcher.ChatPrivate), message(dispatcher.ChatGroup, "x"), false},
+		{"ChatType без чата", dispatcher.ChatType(dispatcher.ChatPrivate), callback("x"), false},
+		{"CallbackPrefix совпадает", dispatcher.CallbackPrefix("choice:"), callback("choice:1"), true},
+		{"CallbackPrefix не совпадает", dispatcher.CallbackPrefix("choice:"), callback("other:1"), false},
+		{"CallbackPrefix для сообщения", dispatcher.CallbackPrefix("choice:"), message(dispatcher.ChatPrivate, "choice:1"), false},
+		{"ContentType текст", dispatcher.ContentType(dispatcher.ContentText), message(dispatcher.ChatPrivate, "x"), true},
+		{"ContentType фото с подписью", dispatcher.ContentType(dispatcher.ContentPhoto), photo, true},
+		{"ContentType фото не текст", dispatcher.ContentType(dispatcher.ContentText), photo, false},
+		{"ContentType без сообщения", dispatcher.ContentType(dispatcher.ContentText), callback("x"), false},
+		{"Command", dispatcher.Command("help", "start"), message(dispatcher.ChatGroup, "/help@GoteBot"), true},
+		{"Command другому боту", dispatcher.Command("help"), message(dispatcher.ChatGroup, "/help@OtherBot"), false},
+		{"Not", dispatcher.Not(dispatcher.ChatType(dispatcher.ChatPrivate)), message(dispatcher.ChatGroup, "x"), true},
+		{"And", dispatcher.And(dispatcher.ChatType(dispatcher.ChatPrivate), dispatcher.Text("x")), message(dispatcher.ChatPrivate, "y"), false},
+		{"Or", dispatcher.Or(dispatcher.Text("y"), dispatcher.Text("x")), message(dispatcher.ChatPrivate, "x"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := dispatcher.NewDispatcher(bot)
+			d.OnAny(func(c *dispatcher.Context) error { return nil }, tt.filter)
+
+			if got := d.ProcessUpdate(context.Background(), tt.update); got != tt.want {
+				t.Fatalf("фильтр вернул %v, ожидалось %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRouteOrder(t *testing.T) {
+	bot, _ := newBot(t)
+
+	d := dispatcher.NewDispatcher(bot)
+
+	var got string
+	route := func(name string) dispatcher.Handler {
+		return func(c *dispatcher.Context) error {
+			got = name
+			return nil
+		}
+	}
+
+	d.OnCommand("start", route("start"))
+	d.OnMessage(route("private"), dispatcher.ChatType(dispatcher.ChatPrivate))
+	d.OnMessage(route("message"))
+	d.OnCallbackQuery(route("callback"))
+	d.OnAny(route("any"))
+
+	tests := []struct {
+		update types.Update
+		want   string
+	}{
+		{message(dispatcher.ChatPrivate, "/start"), "start"},
+		{message(dispatcher.ChatPrivate, "привет"), "private"},
+		{message(dispatcher.ChatGroup, "привет"), "message"},
+		{types.Update{CallbackQuery: &types.CallbackQuery{Id: "1"}}, "callback"},
+		{types.Update{EditedMessage: &types.Message{Chat: &types.Chat{Id: 5}}}, "any"},
+	}
+
+	for _, tt := range tests {
+		got = ""
+		if !d.ProcessUpdate(context.Background(), tt.update) {
+			t.Fatalf("обновление %s не обработано", tt.update.Kind())
+		}
+		if got != tt.want {
+			t.Fatalf("обновление %s обработано маршрутом %q, ожидался %q", tt.update.Kind(), got, tt.want)
+		}
+	}
+
+	// без подходящего маршрута обновление не обрабатывается
+	d = dispatcher.NewDispatcher(bot)
+	d.OnMessage(route("message"))
+	if d.ProcessUpdate(context.Background(), types.Update{CallbackQuery: &types.CallbackQuery{Id: "1"}}) {
+		t.Fatal("обновление обработано без подходящего маршрута")
+	}
+}
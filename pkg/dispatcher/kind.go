@@ -0,0 +1,38 @@
+package dispatcher
+
+import "github.com/WORKHATERS/gote/pkg/types"
+
+// Kind тип обновления, совпадает с именем поля в types.Update
+type Kind = types.UpdateKind
+
+// Типы обновлений
+const (
+	KindMessage                 = types.UpdateKindMessage
+	KindEditedMessage           = types.UpdateKindEditedMessage
+	KindChannelPost             = types.UpdateKindChannelPost
+	KindEditedChannelPost       = types.UpdateKindEditedChannelPost
+	KindBusinessConnection      = types.UpdateKindBusinessConnection
+	KindBusinessMessage         = types.UpdateKindBusinessMessage
+	KindEditedBusinessMessage   = types.UpdateKindEditedBusinessMessage
+	KindDeletedBusinessMessages = types.UpdateKindDeletedBusinessMessages
+	KindMessageReaction         = types.UpdateKindMessageReaction
+	KindMessageReactionCount    = types.UpdateKindMessageReactionCount
+	KindInlineQuery             = types.UpdateKindInlineQuery
+	KindChosenInlineResult      = types.UpdateKindChosenInlineResult
+	KindCallbackQuery           = types.UpdateKindCallbackQuery
+	KindShippingQuery           = types.UpdateKindShippingQuery
+	KindPreCheckoutQuery        = types.UpdateKindPreCheckoutQuery
+	KindPurchasedPaidMedia      = types.UpdateKindPurchasedPaidMedia
+	KindPoll                    = types.UpdateKindPoll
+	KindPollAnswer              = types.UpdateKindPollAnswer
+	KindMyChatMember            = types.UpdateKindMyChatMember
+	KindChatMember              = types.UpdateKindChatMember
+	KindChatJoinRequest         = types.UpdateKindChatJoinRequest
+	KindChatBoost               = types.UpdateKindChatBoost
+	KindRemovedChatBoost        = types.UpdateKindRemovedChatBoost
+)
+
+// KindOf функция определения типа обновления
+func KindOf(u types.Update) Kind {
+	return u.Kind()
+}
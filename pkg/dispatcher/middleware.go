@@ -0,0 +1,55 @@
+package dispatcher
+
+import (
+	"fmt"
+	"runtime/debug"
+	"time"
+)
+
+// Middleware тип промежуточного обработчика обновлений.
+// Позволяет добавить логирование, восстановление после паники, авторизацию, метрики и т.д.
+type Middleware func(next Handler) Handler
+
+// Use метод добавления промежуточных обработчиков ко всем обработчикам диспетчера.
+// Первый обработчик в списке вызывается первым.
+func (d *Dispatcher) Use(mws ...Middleware) {
+	d.middlewares = append(d.middlewares, mws...)
+}
+
+// Recover функция создания промежуточного обработчика, преобразующего панику обработчика в ошибку
+func Recover() Middleware {
+	return func(next Handler) Handler {
+		return func(c *Context) (err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					err = fmt.Errorf("паника при обработке обновления: %v\n%s", r, debug.Stack())
+				}
+			}()
+
+			return next(c)
+		}
+	}
+}
+
+// Logging функция создания промежуточного обработчика, логирующего каждое обновление
+func Logging() Middleware {
+	return func(next Handler) Handler {
+		return func(c *Context) error {
+			start := time.Now()
+			err := next(c)
+
+			attrs := []any{
+				"update_id", c.Update.UpdateId,
+				"kind", c.Kind(),
+				"duration", time.Since(start),
+			}
+			if err != nil {
+				c.Bot.Logger().Error("Обновление обработано с ошибкой", append(attrs, "error", err)...)
+			} else {
+				c.Bot.Logger().Debug("Обновление обработано", attrs...)
+			}
+
+			return err
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package gotetest
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Call структура вызова метода Bot API, полученного фейковым сервером
+type Call struct {
+	// Имя метода, например "sendMessage"
+	Method string
+
+	// Параметры метода, декодированные из JSON или multipart/form-data
+	Params map[string]any
+
+	// Файлы, загруженные через multipart/form-data, по имени части
+	Files map[string]File
+}
+
+// File структура файла, загруженного в вызове метода
+type File struct {
+	// Имя файла
+	Name string
+
+	// Содержимое файла
+	Data []byte
+}
+
+// Decode метод декодирования параметров вызова в структуру параметров метода, например types.SendMessage
+func (c Call) Decode(v any) error {
+	data, err := json.Marshal(c.Params)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// Param метод получения значения параметра вызова
+func (c Call) Param(name string) any {
+	return c.Params[name]
+}
+
+// String метод получения текстового представления вызова
+func (c Call) String() string {
+	data, _ := json.Marshal(c.Params)
+	return fmt.Sprintf("%s %s", c.Method, data)
+}
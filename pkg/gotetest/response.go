@@ -0,0 +1,79 @@
+package gotetest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/WORKHATERS/gote/pkg/types"
+)
+
+// Response структура ответа фейкового сервера на вызов метода
+type Response struct {
+	// Результат успешного вызова (поле result)
+	Result any
+
+	// Код ошибки; если не равен нулю, возвращается ответ с ok=false
+	ErrorCode int
+
+	// Описание ошибки
+	Description string
+
+	// Дополнительные параметры ошибки (retry_after, migrate_to_chat_id)
+	Parameters *types.ResponseParameters
+}
+
+// ResponseFunc тип функции, формирующей ответ на вызов метода
+type ResponseFunc func(call Call) Response
+
+// OK функция создания успешного ответа с результатом
+func OK(result any) Response {
+	return Response{Result: result}
+}
+
+// Error функция создания ответа с ошибкой Telegram
+func Error(code int, description string) Response {
+	return Response{ErrorCode: code, Description: description}
+}
+
+// TooManyRequests функция создания ответа 429 с параметром retry_after в секундах
+func TooManyRequests(retryAfter int64) Response {
+	return Response{
+		ErrorCode:   http.StatusTooManyRequests,
+		Description: "Too Many Requests: retry after " + strconv.FormatInt(retryAfter, 10),
+		Parameters:  &types.ResponseParameters{RetryAfter: retryAfter},
+	}
+}
+
+// Forbidden функция создания ответа 403, например "Forbidden: bot was blocked by the user"
+func Forbidden(description string) Response {
+	return Error(http.StatusForbidden, description)
+}
+
+// status метод получения HTTP-статуса ответа
+func (r Response) status() int {
+	if r.ErrorCode == 0 {
+		return http.StatusOK
+	}
+	if r.ErrorCode >= 400 && r.ErrorCode < 600 {
+		return r.ErrorCode
+	}
+	return http.StatusBadRequest
+}
+
+// body метод получения тела ответа в формате Bot API
+func (r Response) body() map[string]any {
+	if r.ErrorCode == 0 {
+		return map[string]any{"ok": true, "result": r.Result}
+	}
+
+	body := map[string]any{
+		"ok":          false,
+		"error_code":  r.ErrorCode,
+		"description": r.Description,
+	}
+	if r.Parameters != nil {
+		body["parameters"] = r.Parameters
+	}
+
+	return body
+}
@@ -0,0 +1,556 @@
+package gotetest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/WORKHATERS/gote/pkg/core"
+	"github.com/WORKHATERS/gote/pkg/types"
+)
+
+// DefaultToken токен бота, который фейковый сервер принимает по умолчанию
+const DefaultToken = "123456:TEST-TOKEN"
+
+// Server структура фейкового сервера Telegram Bot API на базе httptest.Server.
+// Записывает все вызовы методов, отдаёт добавленные обновления через getUpdates
+// или отправляет их на зарегистрированный webhook и возвращает заданные ответы.
+type Server struct {
+	srv   *httptest.Server
+	token string
+	me    types.User
+
+	mu        sync.Mutex
+	calls     []Call
+	responses map[string][]Response
+	handlers  map[string]ResponseFunc
+	updates   []types.Update
+	nextId    int64
+	messageId int64
+	notify    chan struct{}
+	files     map[string][]byte
+
+	webhookURL    string
+	webhookSecret string
+}
+
+// Option тип функциональных параметров
+type Option func(*Server)
+
+// NewServer функция-конструктор для Server. Сервер запускается сразу и должен быть закрыт через Close.
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		token: DefaultToken,
+		me: types.User{
+			Id:        123456,
+			IsBot:     true,
+			FirstName: "Test Bot",
+			Username:  "test_bot",
+		},
+		responses: make(map[string][]Response),
+		handlers:  make(map[string]ResponseFunc),
+		nextId:    1,
+		notify:    make(chan struct{}),
+		files:     make(map[string][]byte),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	return s
+}
+
+// WithToken функция установки токена бота, который принимает сервер
+func WithToken(token string) Option {
+	return func(s *Server) { s.token = token }
+}
+
+// WithMe функция установки пользователя, возвращаемого методом getMe
+func WithMe(me types.User) Option {
+	return func(s *Server) { s.me = me }
+}
+
+// URL метод получения адреса сервера для core.WithAPIEndpoint
+func (s *Server) URL() string { return s.srv.URL }
+
+// Token метод получения токена бота
+func (s *Server) Token() string { return s.token }
+
+// Client метод получения HTTP-клиента, подключённого к серверу
+func (s *Server) Client() *http.Client { return s.srv.Client() }
+
+// Bot метод создания бота, подключённого к фейковому серверу
+func (s *Server) Bot(ctx context.Context, opts ...core.Option) *core.Bot {
+	opts = append([]core.Option{
+		core.WithAPIEndpoint(s.URL()),
+		core.WithHTTPClient(s.Client()),
+	}, opts...)
+
+	return core.NewBot(ctx, s.token, opts...)
+}
+
+// Close метод остановки сервера
+func (s *Server) Close() {
+	s.mu.Lock()
+	close(s.notify)
+	s.notify = make(chan struct{})
+	s.mu.Unlock()
+
+	s.srv.Close()
+}
+
+// Respond метод добавления ответов на вызовы метода.
+// Ответы возвращаются по одному в порядке добавления, после чего используется ответ по умолчанию.
+func (s *Server) Respond(method string, responses ...Response) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.responses[method] = append(s.responses[method], responses...)
+}
+
+// Handle метод установки функции, формирующей ответы на все вызовы метода.
+// Ответы, добавленные через Respond, имеют приоритет.
+func (s *Server) Handle(method string, f ResponseFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.handlers[method] = f
+}
+
+// AddFile метод добавления файла, доступного через getFile и скачивание
+func (s *Server) AddFile(fileId string, data []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.files[fileId] = data
+}
+
+// Calls метод получения всех записанных вызовов методов
+func (s *Server) Calls() []Call {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]Call(nil), s.calls...)
+}
+
+// CallsTo метод получения записанных вызовов указанного метода
+func (s *Server) CallsTo(method string) []Call {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var calls []Call
+	for _, c := range s.calls {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+
+	return calls
+}
+
+// LastCall метод получения последнего вызова указанного метода
+func (s *Server) LastCall(method string) (Call, bool) {
+	calls := s.CallsTo(method)
+	if len(calls) == 0 {
+		return Call{}, false
+	}
+	return calls[len(calls)-1], true
+}
+
+// Reset метод очистки записанных вызовов, заданных ответов и необработанных обновлений
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls = nil
+	s.responses = make(map[string][]Response)
+	s.handlers = make(map[string]ResponseFunc)
+	s.updates = nil
+}
+
+// AddUpdate метод добавления обновлений, которые будут отданы через getUpdates.
+// Если update_id не задан, он назначается последовательно.
+func (s *Server) AddUpdate(updates ...types.Update) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, u := range updates {
+		s.updates = append(s.updates, s.assignId(u))
+	}
+
+	close(s.notify)
+	s.notify = make(chan struct{})
+}
+
+// AddMessage метод добавления обновления с текстовым сообщением в личном чате
+func (s *Server) AddMessage(chatId int64, text string) {
+	s.mu.Lock()
+	s.messageId++
+	msg := &types.Message{
+		MessageId: s.messageId,
+		Date:      time.Now().Unix(),
+		Chat:      &types.Chat{Id: chatId, Type: "private"},
+		From:      &types.User{Id: chatId, FirstName: "User"},
+		Text:      text,
+	}
+	s.mu.Unlock()
+
+	s.AddUpdate(types.Update{Message: msg})
+}
+
+// PushUpdate метод отправки обновления на webhook, зарегистрированный через setWebhook.
+// Секретный токен передаётся в заголовке, как это делает Telegram.
+func (s *Server) PushUpdate(ctx context.Context, u types.Update) error {
+	s.mu.Lock()
+	u = s.assignId(u)
+	url, secret := s.webhookURL, s.webhookSecret
+	s.mu.Unlock()
+
+	if url == "" {
+		return errors.New("webhook не зарегистрирован")
+	}
+
+	data, err := json.Marshal(u)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if secret != "" {
+		req.Header.Set("X-Telegram-Bot-Api-Secret-Token", secret)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return errors.New("webhook ответил со статусом " + resp.Status)
+	}
+
+	return nil
+}
+
+// WebhookURL метод получения адреса, зарегистрированного через setWebhook
+func (s *Server) WebhookURL() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.webhookURL
+}
+
+func (s *Server) assignId(u types.Update) types.Update {
+	if u.UpdateId == 0 {
+		u.UpdateId = s.nextId
+	}
+	if u.UpdateId >= s.nextId {
+		s.nextId = u.UpdateId + 1
+	}
+	return u
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, "/")
+
+	if rest, ok := strings.CutPrefix(path, "file/bot"); ok {
+		s.serveFile(w, rest)
+		return
+	}
+
+	rest, ok := strings.CutPrefix(path, "bot")
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	token, method, _ := strings.Cut(rest, "/")
+	method = strings.TrimPrefix(method, "test/")
+	if token != s.token {
+		writeResponse(w, Error(http.StatusUnauthorized, "Unauthorized"))
+		return
+	}
+
+	call, err := decodeCall(r, method)
+	if err != nil {
+		writeResponse(w, Error(http.StatusBadRequest, "Bad Request: "+err.Error()))
+		return
+	}
+
+	s.mu.Lock()
+	s.calls = append(s.calls, call)
+	resp, scripted := s.nextResponse(call)
+	s.mu.Unlock()
+
+	if !scripted {
+		resp = s.defaultResponse(r.Context(), call)
+	}
+
+	writeResponse(w, resp)
+}
+
+// nextResponse метод получения заданного ответа на вызов. Вызывается под мьютексом.
+func (s *Server) nextResponse(call Call) (Response, bool) {
+	if queue := s.responses[call.Method]; len(queue) > 0 {
+		s.responses[call.Method] = queue[1:]
+		return queue[0], true
+	}
+
+	if f, ok := s.handlers[call.Method]; ok {
+		return f(call), true
+	}
+
+	return Response{}, false
+}
+
+// defaultResponse метод получения ответа по умолчанию для методов без заданных ответов
+func (s *Server) defaultResponse(ctx context.Context, call Call) Response {
+	switch call.Method {
+	case "getMe":
+		return OK(s.me)
+	case "getUpdates":
+		return OK(s.waitUpdates(ctx, call))
+	case "setWebhook":
+		s.mu.Lock()
+		s.webhookURL, _ = call.Params["url"].(string)
+		s.webhookSecret, _ = call.Params["secret_token"].(string)
+		s.mu.Unlock()
+		return OK(true)
+	case "deleteWebhook":
+		s.mu.Lock()
+		s.webhookURL, s.webhookSecret = "", ""
+		s.mu.Unlock()
+		return OK(true)
+	case "getWebhookInfo":
+		return OK(types.WebhookInfo{Url: s.WebhookURL()})
+	case "getFile":
+		return s.getFile(call)
+	}
+
+	if call.Method == "sendMediaGroup" {
+		return OK(s.newMediaGroup(call))
+	}
+
+	if strings.HasPrefix(call.Method, "send") && call.Method != "sendChatAction" {
+		return OK(s.newMessage(call))
+	}
+
+	return OK(true)
+}
+
+// waitUpdates метод ожидания обновлений для getUpdates с учётом offset, limit и timeout
+func (s *Server) waitUpdates(ctx context.Context, call Call) []types.Update {
+	var params types.GetUpdates
+	_ = call.Decode(&params)
+
+	deadline := time.After(time.Duration(params.Timeout) * time.Second)
+
+	for {
+		s.mu.Lock()
+		if params.Offset != 0 {
+			i := 0
+			for i < len(s.updates) && s.updates[i].UpdateId < params.Offset {
+				i++
+			}
+			s.updates = s.updates[i:]
+		}
+
+		updates := s.updates
+		if params.Limit > 0 && int64(len(updates)) > params.Limit {
+			updates = updates[:params.Limit]
+		}
+		updates = append([]types.Update{}, updates...)
+		notify := s.notify
+		s.mu.Unlock()
+
+		if len(updates) > 0 || params.Timeout == 0 {
+			return updates
+		}
+
+		select {
+		case <-notify:
+		case <-deadline:
+			return updates
+		case <-ctx.Done():
+			return updates
+		}
+	}
+}
+
+// newMessage метод создания сообщения, возвращаемого методами send*
+func (s *Server) newMessage(call Call) types.Message {
+	s.mu.Lock()
+	s.messageId++
+	id := s.messageId
+	s.mu.Unlock()
+
+	msg := types.Message{
+		MessageId: id,
+		Date:      time.Now().Unix(),
+		From:      &s.me,
+		Chat:      &types.Chat{Type: "private"},
+	}
+	msg.Text, _ = call.Params["text"].(string)
+
+	switch chatId := call.Params["chat_id"].(type) {
+	case float64:
+		msg.Chat.Id = int64(chatId)
+		if chatId < 0 {
+			msg.Chat.Type = "supergroup"
+		}
+	case string:
+		if id, err := strconv.ParseInt(chatId, 10, 64); err == nil {
+			msg.Chat.Id = id
+		} else {
+			msg.Chat.Type = "channel"
+			msg.Chat.Username = strings.TrimPrefix(chatId, "@")
+		}
+	}
+
+	return msg
+}
+
+// newMediaGroup метод создания сообщений, возвращаемых методом sendMediaGroup
+func (s *Server) newMediaGroup(call Call) []types.Message {
+	var media []any
+	switch v := call.Params["media"].(type) {
+	case []any:
+		media = v
+	case string:
+		_ = json.Unmarshal([]byte(v), &media)
+	}
+
+	messages := make([]types.Message, 0, len(media))
+	for range media {
+		msg := s.newMessage(call)
+		msg.MediaGroupId = "group"
+		messages = append(messages, msg)
+	}
+
+	return messages
+}
+
+// getFile метод ответа на getFile для файлов, добавленных через AddFile
+func (s *Server) getFile(call Call) Response {
+	fileId, _ := call.Params["file_id"].(string)
+
+	s.mu.Lock()
+	data, ok := s.files[fileId]
+	s.mu.Unlock()
+
+	if !ok {
+		return Error(http.StatusBadRequest, "Bad Request: invalid file_id")
+	}
+
+	return OK(types.File{
+		FileId:       fileId,
+		FileUniqueId: fileId,
+		FileSize:     int64(len(data)),
+		FilePath:     "files/" + fileId,
+	})
+}
+
+// serveFile метод отдачи содержимого файла по адресу /file/bot<token>/<file_path>
+func (s *Server) serveFile(w http.ResponseWriter, rest string) {
+	token, path, _ := strings.Cut(rest, "/")
+	path = strings.TrimPrefix(path, "test/")
+	if token != s.token {
+		writeResponse(w, Error(http.StatusUnauthorized, "Unauthorized"))
+		return
+	}
+
+	s.mu.Lock()
+	data, ok := s.files[strings.TrimPrefix(path, "files/")]
+	s.mu.Unlock()
+
+	if !ok {
+		writeResponse(w, Error(http.StatusNotFound, "Not Found"))
+		return
+	}
+
+	w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+	w.Write(data)
+}
+
+// decodeCall функция декодирования параметров вызова из JSON или multipart/form-data
+func decodeCall(r *http.Request, method string) (Call, error) {
+	call := Call{
+		Method: method,
+		Params: make(map[string]any),
+		Files:  make(map[string]File),
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch mediaType {
+	case "multipart/form-data":
+		if err := r.ParseMultipartForm(32 << 20); err != nil {
+			return call, err
+		}
+
+		for name, values := range r.MultipartForm.Value {
+			call.Params[name] = formValue(values[0])
+		}
+
+		for name, headers := range r.MultipartForm.File {
+			f, err := headers[0].Open()
+			if err != nil {
+				return call, err
+			}
+			data, err := io.ReadAll(f)
+			f.Close()
+			if err != nil {
+				return call, err
+			}
+
+			call.Files[name] = File{Name: headers[0].Filename, Data: data}
+			call.Params[name] = "attach://" + name
+		}
+	default:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			return call, err
+		}
+		if len(bytes.TrimSpace(data)) > 0 {
+			if err := json.Unmarshal(data, &call.Params); err != nil {
+				return call, err
+			}
+		}
+	}
+
+	return call, nil
+}
+
+// formValue функция декодирования значения поля формы: JSON-значения декодируются, остальные остаются строками
+func formValue(s string) any {
+	var v any
+	if err := json.Unmarshal([]byte(s), &v); err == nil {
+		if _, isString := v.(string); !isString {
+			return v
+		}
+	}
+	return s
+}
+
+// writeResponse функция записи ответа в формате Bot API
+func writeResponse(w http.ResponseWriter, resp Response) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(resp.status())
+	json.NewEncoder(w).Encode(resp.body())
+}
@@ -0,0 +1,232 @@
+package gotetest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/WORKHATERS/gote/pkg/core"
+	"github.com/WORKHATERS/gote/pkg/gotetest"
+	"github.com/WORKHATERS/gote/pkg/types"
+	"github.com/WORKHATERS/gote/pkg/updater"
+)
+
+func newBot(t *testing.T, s *gotetest.Server) *core.Bot {
+	t.Helper()
+
+	b := s.Bot(context.Background())
+	t.Cleanup(b.Stop)
+
+	return b
+}
+
+func updateIds(updates []types.Update) []int64 {
+	ids := make([]int64, 0, len(updates))
+	for _, u := range updates {
+		ids = append(ids, u.UpdateId)
+	}
+	return ids
+}
+
+func TestGetUpdates(t *testing.T) {
+	s := gotetest.NewServer()
+	defer s.Close()
+
+	bot := newBot(t, s)
+	ctx := context.Background()
+
+	s.AddMessage(5, "a")
+	s.AddMessage(5, "b")
+	s.AddMessage(5, "c")
+
+	updates, err := bot.GetUpdates(ctx, types.GetUpdates{Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids := updateIds(updates); len(ids) != 2 || ids[0] != 1 || ids[1] != 2 {
+		t.Fatalf("limit: получены обновления %v, ожидались [1 2]", ids)
+	}
+
+	updates, err = bot.GetUpdates(ctx, types.GetUpdates{Offset: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids := updateIds(updates); len(ids) != 1 || ids[0] != 3 || updates[0].Message.Text != "c" {
+		t.Fatalf("offset: получены обновления %v, ожидалось [3]", ids)
+	}
+
+	// подтверждённые обновления больше не отдаются
+	updates, err = bot.GetUpdates(ctx, types.GetUpdates{Offset: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(updates) != 0 {
+		t.Fatalf("после подтверждения получены обновления %v", updateIds(updates))
+	}
+
+	// без обновлений запрос ждёт timeout
+	start := time.Now()
+	updates, err = bot.GetUpdates(ctx, types.GetUpdates{Offset: 4, Timeout: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := time.Since(start); len(updates) != 0 || d < 900*time.Millisecond {
+		t.Fatalf("timeout: получено %d обновлений через %v, ожидалось ожидание 1s", len(updates), d)
+	}
+
+	// новое обновление завершает ожидание раньше timeout
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		s.AddMessage(5, "d")
+	}()
+
+	start = time.Now()
+	updates, err = bot.GetUpdates(ctx, types.GetUpdates{Offset: 4, Timeout: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := time.Since(start); len(updates) != 1 || d > 2*time.Second {
+		t.Fatalf("long poll: получено %d обновлений через %v", len(updates), d)
+	}
+
+	if call, ok := s.LastCall("getUpdates"); !ok || call.Param("timeout") != float64(5) {
+		t.Fatalf("последний вызов getUpdates: %v", call)
+	}
+}
+
+func TestRespondErrors(t *testing.T) {
+	s := gotetest.NewServer()
+	defer s.Close()
+
+	bot := newBot(t, s)
+	ctx := context.Background()
+
+	s.Respond("sendMessage", gotetest.TooManyRequests(3), gotetest.Forbidden("Forbidden: bot was blocked by the user"))
+
+	params := types.SendMessage{ChatId: types.ChatIDInt(5), Text: "привет"}
+
+	_, err := bot.SendMessage(ctx, params)
+	var apiErr *core.APIError
+	if !errors.Is(err, core.ErrTooManyRequests) || !errors.As(err, &apiErr) {
+		t.Fatalf("первый вызов: ошибка %v, ожидалась 429", err)
+	}
+	if apiErr.RetryAfter() != 3*time.Second {
+		t.Fatalf("retry_after %v, ожидалось 3s", apiErr.RetryAfter())
+	}
+
+	_, err = bot.SendMessage(ctx, params)
+	if !errors.Is(err, core.ErrForbidden) {
+		t.Fatalf("второй вызов: ошибка %v, ожидалась 403", err)
+	}
+
+	// после заданных ответов используется ответ по умолчанию
+	msg, err := bot.SendMessage(ctx, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.Text != "привет" || msg.Chat == nil || msg.Chat.Id != 5 {
+		t.Fatalf("ответ по умолчанию: %+v", msg)
+	}
+
+	if calls := s.CallsTo("sendMessage"); len(calls) != 3 {
+		t.Fatalf("записано %d вызовов sendMessage, ожидалось 3", len(calls))
+	}
+}
+
+func TestPushUpdateToWebhook(t *testing.T) {
+	s := gotetest.NewServer()
+	defer s.Close()
+
+	bot := newBot(t, s)
+
+	var handler http.Handler
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler.ServeHTTP(w, r)
+	}))
+	defer ts.Close()
+
+	wh := updater.NewWebhook(bot, ts.URL, updater.WithSecretToken("secret"))
+	handler = wh.Handler()
+	updates := wh.Start()
+
+	if got := s.WebhookURL(); got != ts.URL {
+		t.Fatalf("зарегистрирован webhook %q, ожидался %q", got, ts.URL)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := s.PushUpdate(ctx, types.Update{Message: &types.Message{Text: "через webhook"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case u := <-updates:
+		if u.UpdateId != 1 || u.Message == nil || u.Message.Text != "через webhook" {
+			t.Fatalf("получено обновление %+v", u)
+		}
+	case <-ctx.Done():
+		t.Fatal("обновление не получено")
+	}
+
+	// без секретного заголовка webhook отклоняет запрос
+	resp, err := http.Post(ts.URL, "application/json", strings.NewReader(`{"update_id":2}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("запрос без секрета: статус %d, ожидался 401", resp.StatusCode)
+	}
+
+	if err := wh.Stop(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if s.WebhookURL() != "" {
+		t.Fatal("webhook не удалён при остановке")
+	}
+	if err := s.PushUpdate(ctx, types.Update{}); err == nil {
+		t.Fatal("PushUpdate без webhook должен вернуть ошибку")
+	}
+}
+
+func TestMultipartFiles(t *testing.T) {
+	s := gotetest.NewServer()
+	defer s.Close()
+
+	bot := newBot(t, s)
+
+	_, err := bot.SendPhoto(context.Background(), types.SendPhoto{
+		ChatId:  types.ChatIDInt(5),
+		Photo:   types.InputFileReader("cat.jpg", strings.NewReader("jpeg data")),
+		Caption: "кот",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	call, ok := s.LastCall("sendPhoto")
+	if !ok {
+		t.Fatal("вызов sendPhoto не записан")
+	}
+
+	file, ok := call.Files["photo"]
+	if !ok {
+		t.Fatalf("файл photo не найден, файлы вызова: %v", call.Files)
+	}
+	if file.Name != "cat.jpg" || string(file.Data) != "jpeg data" {
+		t.Fatalf("файл %q с содержимым %q", file.Name, file.Data)
+	}
+
+	var params types.SendPhoto
+	if err := call.Decode(&params); err != nil {
+		t.Fatal(err)
+	}
+	if params.Caption != "кот" || params.ChatId != types.ChatIDInt(5) {
+		t.Fatalf("параметры вызова: %s", call)
+	}
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// ChatID идентификатор чата: числовой id или имя пользователя канала/супергруппы в формате @username.
+// Пустое значение не передаётся в запросе.
+type ChatID string
+
+// ChatIDInt функция создания идентификатора чата по числовому id
+func ChatIDInt(id int64) ChatID {
+	return ChatID(strconv.FormatInt(id, 10))
+}
+
+// ChatIDUsername функция создания идентификатора чата по имени пользователя канала или супергруппы
+func ChatIDUsername(username string) ChatID {
+	if !strings.HasPrefix(username, "@") {
+		username = "@" + username
+	}
+	return ChatID(username)
+}
+
+// Int метод получения числового id чата; false, если идентификатор задан именем пользователя
+func (c ChatID) Int() (int64, bool) {
+	id, err := strconv.ParseInt(string(c), 10, 64)
+	return id, err == nil
+}
+
+// Username метод получения имени пользователя чата; пустая строка, если идентификатор числовой
+func (c ChatID) Username() string {
+	if _, ok := c.Int(); ok {
+		return ""
+	}
+	return string(c)
+}
+
+// String метод получения строкового представления идентификатора
+func (c ChatID) String() string { return string(c) }
+
+// MarshalJSON метод сериализации идентификатора в число или строку
+func (c ChatID) MarshalJSON() ([]byte, error) {
+	if id, ok := c.Int(); ok {
+		return strconv.AppendInt(nil, id, 10), nil
+	}
+	return json.Marshal(string(c))
+}
+
+// UnmarshalJSON метод десериализации идентификатора из числа или строки
+func (c *ChatID) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*c = ChatID(s)
+		return nil
+	}
+
+	var id int64
+	if err := json.Unmarshal(data, &id); err != nil {
+		return err
+	}
+	*c = ChatIDInt(id)
+
+	return nil
+}
@@ -101,7 +101,7 @@ type SendMessage struct {
 	BusinessConnectionId string `json:"business_connection_id,omitempty"`
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
 	MessageThreadId int64 `json:"message_thread_id,omitempty"`
@@ -150,7 +150,7 @@ type SendMessage struct {
 type ForwardMessage struct {
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
 	MessageThreadId int64 `json:"message_thread_id,omitempty"`
@@ -159,7 +159,7 @@ type ForwardMessage struct {
 	DirectMessagesTopicId int64 `json:"direct_messages_topic_id,omitempty"`
 	
 	// Unique identifier for the chat where the original message was sent (or channel username in the format @channelusername)
-	FromChatId int64 `json:"from_chat_id,omitempty"`
+	FromChatId ChatID `json:"from_chat_id,omitempty"`
 	
 	// New start timestamp for the forwarded video in the message
 	VideoStartTimestamp int64 `json:"video_start_timestamp,omitempty"`
@@ -184,7 +184,7 @@ type ForwardMessage struct {
 type ForwardMessages struct {
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
 	MessageThreadId int64 `json:"message_thread_id,omitempty"`
@@ -193,7 +193,7 @@ type ForwardMessages struct {
 	DirectMessagesTopicId int64 `json:"direct_messages_topic_id,omitempty"`
 	
 	// Unique identifier for the chat where the original messages were sent (or channel username in the format @channelusername)
-	FromChatId int64 `json:"from_chat_id,omitempty"`
+	FromChatId ChatID `json:"from_chat_id,omitempty"`
 	
 	// A JSON-serialized list of 1-100 identifiers of messages in the chat from_chat_id to forward. The identifiers must be specified in a strictly increasing order.
 	MessageIds []int64 `json:"message_ids,omitempty"`
@@ -212,7 +212,7 @@ type ForwardMessages struct {
 type CopyMessage struct {
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
 	MessageThreadId int64 `json:"message_thread_id,omitempty"`
@@ -221,7 +221,7 @@ type CopyMessage struct {
 	DirectMessagesTopicId int64 `json:"direct_messages_topic_id,omitempty"`
 	
 	// Unique identifier for the chat where the original message was sent (or channel username in the format @channelusername)
-	FromChatId int64 `json:"from_chat_id,omitempty"`
+	FromChatId ChatID `json:"from_chat_id,omitempty"`
 	
 	// Message identifier in the chat specified in from_chat_id
 	MessageId int64 `json:"message_id,omitempty"`
@@ -267,7 +267,7 @@ type CopyMessage struct {
 type CopyMessages struct {
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
 	MessageThreadId int64 `json:"message_thread_id,omitempty"`
@@ -276,7 +276,7 @@ type CopyMessages struct {
 	DirectMessagesTopicId int64 `json:"direct_messages_topic_id,omitempty"`
 	
 	// Unique identifier for the chat where the original messages were sent (or channel username in the format @channelusername)
-	FromChatId int64 `json:"from_chat_id,omitempty"`
+	FromChatId ChatID `json:"from_chat_id,omitempty"`
 	
 	// A JSON-serialized list of 1-100 identifiers of messages in the chat from_chat_id to copy. The identifiers must be specified in a strictly increasing order.
 	MessageIds []int64 `json:"message_ids,omitempty"`
@@ -301,7 +301,7 @@ type SendPhoto struct {
 	BusinessConnectionId string `json:"business_connection_id,omitempty"`
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
 	MessageThreadId int64 `json:"message_thread_id,omitempty"`
@@ -359,7 +359,7 @@ type SendAudio struct {
 	BusinessConnectionId string `json:"business_connection_id,omitempty"`
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
 	MessageThreadId int64 `json:"message_thread_id,omitempty"`
@@ -423,7 +423,7 @@ type SendDocument struct {
 	BusinessConnectionId string `json:"business_connection_id,omitempty"`
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
 	MessageThreadId int64 `json:"message_thread_id,omitempty"`
@@ -481,7 +481,7 @@ type SendVideo struct {
 	BusinessConnectionId string `json:"business_connection_id,omitempty"`
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
 	MessageThreadId int64 `json:"message_thread_id,omitempty"`
@@ -560,7 +560,7 @@ type SendAnimation struct {
 	BusinessConnectionId string `json:"business_connection_id,omitempty"`
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
 	MessageThreadId int64 `json:"message_thread_id,omitempty"`
@@ -630,7 +630,7 @@ type SendVoice struct {
 	BusinessConnectionId string `json:"business_connection_id,omitempty"`
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
 	MessageThreadId int64 `json:"message_thread_id,omitempty"`
@@ -685,7 +685,7 @@ type SendVideoNote struct {
 	BusinessConnectionId string `json:"business_connection_id,omitempty"`
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
 	MessageThreadId int64 `json:"message_thread_id,omitempty"`
@@ -737,7 +737,7 @@ type SendPaidMedia struct {
 	BusinessConnectionId string `json:"business_connection_id,omitempty"`
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername). If the chat is a channel, all Telegram Star proceeds from this media will be credited to the chat&#39;s balance. Otherwise, they will be credited to the bot&#39;s balance.
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
 	MessageThreadId int64 `json:"message_thread_id,omitempty"`
@@ -795,7 +795,7 @@ type SendMediaGroup struct {
 	BusinessConnectionId string `json:"business_connection_id,omitempty"`
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
 	MessageThreadId int64 `json:"message_thread_id,omitempty"`
@@ -832,7 +832,7 @@ type SendLocation struct {
 	BusinessConnectionId string `json:"business_connection_id,omitempty"`
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
 	MessageThreadId int64 `json:"message_thread_id,omitempty"`
@@ -890,7 +890,7 @@ type SendVenue struct {
 	BusinessConnectionId string `json:"business_connection_id,omitempty"`
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
 	MessageThreadId int64 `json:"message_thread_id,omitempty"`
@@ -954,7 +954,7 @@ type SendContact struct {
 	BusinessConnectionId string `json:"business_connection_id,omitempty"`
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
 	MessageThreadId int64 `json:"message_thread_id,omitempty"`
@@ -1006,7 +1006,7 @@ type SendPoll struct {
 	BusinessConnectionId string `json:"business_connection_id,omitempty"`
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername). Polls can&#39;t be sent to channel direct messages chats.
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
 	MessageThreadId int64 `json:"message_thread_id,omitempty"`
@@ -1113,7 +1113,7 @@ type SendDice struct {
 	BusinessConnectionId string `json:"business_connection_id,omitempty"`
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
 	MessageThreadId int64 `json:"message_thread_id,omitempty"`
@@ -1158,7 +1158,7 @@ type SendChatAction struct {
 	BusinessConnectionId string `json:"business_connection_id,omitempty"`
 	
 	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername). Channel chats and channel direct messages chats aren&#39;t supported.
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier for the target message thread; for supergroups only
 	MessageThreadId int64 `json:"message_thread_id,omitempty"`
@@ -1174,7 +1174,7 @@ type SendChatAction struct {
 type SetMessageReaction struct {
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Identifier of the target message. If the message belongs to a media group, the reaction is set to the first non-deleted message in the group instead.
 	MessageId int64 `json:"message_id,omitempty"`
@@ -1235,7 +1235,7 @@ type GetFile struct {
 type BanChatMember struct {
 	
 	// Unique identifier for the target group or username of the target supergroup or channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier of the target user
 	UserId int64 `json:"user_id,omitempty"`
@@ -1254,7 +1254,7 @@ type BanChatMember struct {
 type UnbanChatMember struct {
 	
 	// Unique identifier for the target group or username of the target supergroup or channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier of the target user
 	UserId int64 `json:"user_id,omitempty"`
@@ -1270,7 +1270,7 @@ type UnbanChatMember struct {
 type RestrictChatMember struct {
 	
 	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier of the target user
 	UserId int64 `json:"user_id,omitempty"`
@@ -1292,7 +1292,7 @@ type RestrictChatMember struct {
 type PromoteChatMember struct {
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier of the target user
 	UserId int64 `json:"user_id,omitempty"`
@@ -1353,7 +1353,7 @@ type PromoteChatMember struct {
 type SetChatAdministratorCustomTitle struct {
 	
 	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier of the target user
 	UserId int64 `json:"user_id,omitempty"`
@@ -1369,7 +1369,7 @@ type SetChatAdministratorCustomTitle struct {
 type BanChatSenderChat struct {
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier of the target sender chat
 	SenderChatId int64 `json:"sender_chat_id,omitempty"`
@@ -1382,7 +1382,7 @@ type BanChatSenderChat struct {
 type UnbanChatSenderChat struct {
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier of the target sender chat
 	SenderChatId int64 `json:"sender_chat_id,omitempty"`
@@ -1395,7 +1395,7 @@ type UnbanChatSenderChat struct {
 type SetChatPermissions struct {
 	
 	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// A JSON-serialized object for new default chat permissions
 	Permissions *ChatPermissions `json:"permissions,omitempty"`
@@ -1413,7 +1413,7 @@ type SetChatPermissions struct {
 type ExportChatInviteLink struct {
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 }
 
@@ -1423,7 +1423,7 @@ type ExportChatInviteLink struct {
 type CreateChatInviteLink struct {
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Invite link name; 0-32 characters
 	Name string `json:"name,omitempty"`
@@ -1445,7 +1445,7 @@ type CreateChatInviteLink struct {
 type EditChatInviteLink struct {
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// The invite link to edit
 	InviteLink string `json:"invite_link,omitempty"`
@@ -1470,7 +1470,7 @@ type EditChatInviteLink struct {
 type CreateChatSubscriptionInviteLink struct {
 	
 	// Unique identifier for the target channel chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Invite link name; 0-32 characters
 	Name string `json:"name,omitempty"`
@@ -1489,7 +1489,7 @@ type CreateChatSubscriptionInviteLink struct {
 type EditChatSubscriptionInviteLink struct {
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// The invite link to edit
 	InviteLink string `json:"invite_link,omitempty"`
@@ -1505,7 +1505,7 @@ type EditChatSubscriptionInviteLink struct {
 type RevokeChatInviteLink struct {
 	
 	// Unique identifier of the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// The invite link to revoke
 	InviteLink string `json:"invite_link,omitempty"`
@@ -1518,7 +1518,7 @@ type RevokeChatInviteLink struct {
 type ApproveChatJoinRequest struct {
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier of the target user
 	UserId int64 `json:"user_id,omitempty"`
@@ -1531,7 +1531,7 @@ type ApproveChatJoinRequest struct {
 type DeclineChatJoinRequest struct {
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier of the target user
 	UserId int64 `json:"user_id,omitempty"`
@@ -1544,7 +1544,7 @@ type DeclineChatJoinRequest struct {
 type SetChatPhoto struct {
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// New chat photo, uploaded using multipart/form-data
 	Photo *InputFile `json:"photo,omitempty"`
@@ -1557,7 +1557,7 @@ type SetChatPhoto struct {
 type DeleteChatPhoto struct {
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 }
 
@@ -1567,7 +1567,7 @@ type DeleteChatPhoto struct {
 type SetChatTitle struct {
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// New chat title, 1-128 characters
 	Title string `json:"title,omitempty"`
@@ -1580,7 +1580,7 @@ type SetChatTitle struct {
 type SetChatDescription struct {
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// New chat description, 0-255 characters
 	Description string `json:"description,omitempty"`
@@ -1596,7 +1596,7 @@ type PinChatMessage struct {
 	BusinessConnectionId string `json:"business_connection_id,omitempty"`
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Identifier of a message to pin
 	MessageId int64 `json:"message_id,omitempty"`
@@ -1615,7 +1615,7 @@ type UnpinChatMessage struct {
 	BusinessConnectionId string `json:"business_connection_id,omitempty"`
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Identifier of the message to unpin. Required if business_connection_id is specified. If not specified, the most recent pinned message (by sending date) will be unpinned.
 	MessageId int64 `json:"message_id,omitempty"`
@@ -1628,7 +1628,7 @@ type UnpinChatMessage struct {
 type UnpinAllChatMessages struct {
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 }
 
@@ -1638,7 +1638,7 @@ type UnpinAllChatMessages struct {
 type LeaveChat struct {
 	
 	// Unique identifier for the target chat or username of the target supergroup or channel (in the format @channelusername). Channel direct messages chats aren&#39;t supported; leave the corresponding channel instead.
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 }
 
@@ -1648,7 +1648,7 @@ type LeaveChat struct {
 type GetChat struct {
 	
 	// Unique identifier for the target chat or username of the target supergroup or channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 }
 
@@ -1658,7 +1658,7 @@ type GetChat struct {
 type GetChatAdministrators struct {
 	
 	// Unique identifier for the target chat or username of the target supergroup or channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 }
 
@@ -1668,7 +1668,7 @@ type GetChatAdministrators struct {
 type GetChatMemberCount struct {
 	
 	// Unique identifier for the target chat or username of the target supergroup or channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 }
 
@@ -1678,7 +1678,7 @@ type GetChatMemberCount struct {
 type GetChatMember struct {
 	
 	// Unique identifier for the target chat or username of the target supergroup or channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier of the target user
 	UserId int64 `json:"user_id,omitempty"`
@@ -1691,7 +1691,7 @@ type GetChatMember struct {
 type SetChatStickerSet struct {
 	
 	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Name of the sticker set to be set as the group sticker set
 	StickerSetName string `json:"sticker_set_name,omitempty"`
@@ -1704,7 +1704,7 @@ type SetChatStickerSet struct {
 type DeleteChatStickerSet struct {
 	
 	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 }
 
@@ -1721,7 +1721,7 @@ type GetForumTopicIconStickers struct {
 type CreateForumTopic struct {
 	
 	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Topic name, 1-128 characters
 	Name string `json:"name,omitempty"`
@@ -1740,7 +1740,7 @@ type CreateForumTopic struct {
 type EditForumTopic struct {
 	
 	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier for the target message thread of the forum topic
 	MessageThreadId int64 `json:"message_thread_id,omitempty"`
@@ -1759,7 +1759,7 @@ type EditForumTopic struct {
 type CloseForumTopic struct {
 	
 	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier for the target message thread of the forum topic
 	MessageThreadId int64 `json:"message_thread_id,omitempty"`
@@ -1772,7 +1772,7 @@ type CloseForumTopic struct {
 type ReopenForumTopic struct {
 	
 	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier for the target message thread of the forum topic
 	MessageThreadId int64 `json:"message_thread_id,omitempty"`
@@ -1785,7 +1785,7 @@ type ReopenForumTopic struct {
 type DeleteForumTopic struct {
 	
 	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier for the target message thread of the forum topic
 	MessageThreadId int64 `json:"message_thread_id,omitempty"`
@@ -1798,7 +1798,7 @@ type DeleteForumTopic struct {
 type UnpinAllForumTopicMessages struct {
 	
 	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier for the target message thread of the forum topic
 	MessageThreadId int64 `json:"message_thread_id,omitempty"`
@@ -1811,7 +1811,7 @@ type UnpinAllForumTopicMessages struct {
 type EditGeneralForumTopic struct {
 	
 	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// New topic name, 1-128 characters
 	Name string `json:"name,omitempty"`
@@ -1824,7 +1824,7 @@ type EditGeneralForumTopic struct {
 type CloseGeneralForumTopic struct {
 	
 	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 }
 
@@ -1834,7 +1834,7 @@ type CloseGeneralForumTopic struct {
 type ReopenGeneralForumTopic struct {
 	
 	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 }
 
@@ -1844,7 +1844,7 @@ type ReopenGeneralForumTopic struct {
 type HideGeneralForumTopic struct {
 	
 	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 }
 
@@ -1854,7 +1854,7 @@ type HideGeneralForumTopic struct {
 type UnhideGeneralForumTopic struct {
 	
 	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 }
 
@@ -1864,7 +1864,7 @@ type UnhideGeneralForumTopic struct {
 type UnpinAllGeneralForumTopicMessages struct {
 	
 	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 }
 
@@ -1898,7 +1898,7 @@ type AnswerCallbackQuery struct {
 type GetUserChatBoosts struct {
 	
 	// Unique identifier for the chat or username of the channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier of the target user
 	UserId int64 `json:"user_id,omitempty"`
@@ -1924,7 +1924,7 @@ type SetMyCommands struct {
 	Commands []BotCommand `json:"commands,omitempty"`
 	
 	// A JSON-serialized object, describing scope of users for which the commands are relevant. Defaults to BotCommandScopeDefault.
-	Scope *BotCommandScope `json:"scope,omitempty"`
+	Scope BotCommandScope `json:"scope,omitempty"`
 	
 	// A two-letter ISO 639-1 language code. If empty, commands will be applied to all users from the given scope, for whose language there are no dedicated commands
 	LanguageCode string `json:"language_code,omitempty"`
@@ -1937,7 +1937,7 @@ type SetMyCommands struct {
 type DeleteMyCommands struct {
 	
 	// A JSON-serialized object, describing scope of users for which the commands are relevant. Defaults to BotCommandScopeDefault.
-	Scope *BotCommandScope `json:"scope,omitempty"`
+	Scope BotCommandScope `json:"scope,omitempty"`
 	
 	// A two-letter ISO 639-1 language code. If empty, commands will be applied to all users from the given scope, for whose language there are no dedicated commands
 	LanguageCode string `json:"language_code,omitempty"`
@@ -1950,7 +1950,7 @@ type DeleteMyCommands struct {
 type GetMyCommands struct {
 	
 	// A JSON-serialized object, describing scope of users. Defaults to BotCommandScopeDefault.
-	Scope *BotCommandScope `json:"scope,omitempty"`
+	Scope BotCommandScope `json:"scope,omitempty"`
 	
 	// A two-letter ISO 639-1 language code or an empty string
 	LanguageCode string `json:"language_code,omitempty"`
@@ -2035,7 +2035,7 @@ type SetChatMenuButton struct {
 	ChatId int64 `json:"chat_id,omitempty"`
 	
 	// A JSON-serialized object for the bot&#39;s new menu button. Defaults to MenuButtonDefault
-	MenuButton *MenuButton `json:"menu_button,omitempty"`
+	MenuButton MenuButton `json:"menu_button,omitempty"`
 	
 }
 
@@ -2088,7 +2088,7 @@ type SendGift struct {
 	UserId int64 `json:"user_id,omitempty"`
 	
 	// Required if user_id is not specified. Unique identifier for the chat or username of the channel (in the format @channelusername) that will receive the gift.
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Identifier of the gift
 	GiftId string `json:"gift_id,omitempty"`
@@ -2151,7 +2151,7 @@ type VerifyUser struct {
 type VerifyChat struct {
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername). Channel direct messages chats can&#39;t be verified.
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Custom description for the verification; 0-70 characters. Must be empty if the organization isn&#39;t allowed to provide a custom verification description.
 	CustomDescription string `json:"custom_description,omitempty"`
@@ -2174,7 +2174,7 @@ type RemoveUserVerification struct {
 type RemoveChatVerification struct {
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 }
 
@@ -2258,7 +2258,7 @@ type SetBusinessAccountProfilePhoto struct {
 	BusinessConnectionId string `json:"business_connection_id,omitempty"`
 	
 	// The new profile photo to set
-	Photo *InputProfilePhoto `json:"photo,omitempty"`
+	Photo InputProfilePhoto `json:"photo,omitempty"`
 	
 	// Pass True to set the public photo, which will be visible even if the main photo is hidden by the business account&#39;s privacy settings. An account can have only one public photo.
 	IsPublic bool `json:"is_public,omitempty"`
@@ -2411,7 +2411,7 @@ type PostStory struct {
 	BusinessConnectionId string `json:"business_connection_id,omitempty"`
 	
 	// Content of the story
-	Content *InputStoryContent `json:"content,omitempty"`
+	Content InputStoryContent `json:"content,omitempty"`
 	
 	// Period after which the story is moved to the archive, in seconds; must be one of 6 * 3600, 12 * 3600, 86400, or 2 * 86400
 	ActivePeriod int64 `json:"active_period,omitempty"`
@@ -2448,7 +2448,7 @@ type EditStory struct {
 	StoryId int64 `json:"story_id,omitempty"`
 	
 	// Content of the story
-	Content *InputStoryContent `json:"content,omitempty"`
+	Content InputStoryContent `json:"content,omitempty"`
 	
 	// Caption of the story, 0-2048 characters after entities parsing
 	Caption string `json:"caption,omitempty"`
@@ -2486,7 +2486,7 @@ type EditMessageText struct {
 	BusinessConnectionId string `json:"business_connection_id,omitempty"`
 	
 	// Required if inline_message_id is not specified. Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Required if inline_message_id is not specified. Identifier of the message to edit
 	MessageId int64 `json:"message_id,omitempty"`
@@ -2520,7 +2520,7 @@ type EditMessageCaption struct {
 	BusinessConnectionId string `json:"business_connection_id,omitempty"`
 	
 	// Required if inline_message_id is not specified. Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Required if inline_message_id is not specified. Identifier of the message to edit
 	MessageId int64 `json:"message_id,omitempty"`
@@ -2554,7 +2554,7 @@ type EditMessageMedia struct {
 	BusinessConnectionId string `json:"business_connection_id,omitempty"`
 	
 	// Required if inline_message_id is not specified. Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Required if inline_message_id is not specified. Identifier of the message to edit
 	MessageId int64 `json:"message_id,omitempty"`
@@ -2563,7 +2563,7 @@ type EditMessageMedia struct {
 	InlineMessageId string `json:"inline_message_id,omitempty"`
 	
 	// A JSON-serialized object for a new media content of the message
-	Media *InputMedia `json:"media,omitempty"`
+	Media InputMedia `json:"media,omitempty"`
 	
 	// A JSON-serialized object for a new inline keyboard.
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
@@ -2579,7 +2579,7 @@ type EditMessageLiveLocation struct {
 	BusinessConnectionId string `json:"business_connection_id,omitempty"`
 	
 	// Required if inline_message_id is not specified. Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Required if inline_message_id is not specified. Identifier of the message to edit
 	MessageId int64 `json:"message_id,omitempty"`
@@ -2619,7 +2619,7 @@ type StopMessageLiveLocation struct {
 	BusinessConnectionId string `json:"business_connection_id,omitempty"`
 	
 	// Required if inline_message_id is not specified. Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Required if inline_message_id is not specified. Identifier of the message with live location to stop
 	MessageId int64 `json:"message_id,omitempty"`
@@ -2663,7 +2663,7 @@ type EditMessageReplyMarkup struct {
 	BusinessConnectionId string `json:"business_connection_id,omitempty"`
 	
 	// Required if inline_message_id is not specified. Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Required if inline_message_id is not specified. Identifier of the message to edit
 	MessageId int64 `json:"message_id,omitempty"`
@@ -2685,7 +2685,7 @@ type StopPoll struct {
 	BusinessConnectionId string `json:"business_connection_id,omitempty"`
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Identifier of the original message with the poll
 	MessageId int64 `json:"message_id,omitempty"`
@@ -2733,7 +2733,7 @@ type DeclineSuggestedPost struct {
 type DeleteMessage struct {
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Identifier of the message to delete
 	MessageId int64 `json:"message_id,omitempty"`
@@ -2746,7 +2746,7 @@ type DeleteMessage struct {
 type DeleteMessages struct {
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// A JSON-serialized list of 1-100 identifiers of messages to delete. See deleteMessage for limitations on which messages can be deleted
 	MessageIds []int64 `json:"message_ids,omitempty"`
@@ -2762,7 +2762,7 @@ type SendSticker struct {
 	BusinessConnectionId string `json:"business_connection_id,omitempty"`
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
 	MessageThreadId int64 `json:"message_thread_id,omitempty"`
@@ -3046,7 +3046,7 @@ type AnswerWebAppQuery struct {
 	WebAppQueryId string `json:"web_app_query_id,omitempty"`
 	
 	// A JSON-serialized object describing the message to be sent
-	Result *InlineQueryResult `json:"result,omitempty"`
+	Result InlineQueryResult `json:"result,omitempty"`
 	
 }
 
@@ -3059,7 +3059,7 @@ type SavePreparedInlineMessage struct {
 	UserId int64 `json:"user_id,omitempty"`
 	
 	// A JSON-serialized object describing the message to be sent
-	Result *InlineQueryResult `json:"result,omitempty"`
+	Result InlineQueryResult `json:"result,omitempty"`
 	
 	// Pass True if the message can be sent to private chats with users
 	AllowUserChats bool `json:"allow_user_chats,omitempty"`
@@ -3081,7 +3081,7 @@ type SavePreparedInlineMessage struct {
 type SendInvoice struct {
 	
 	// Unique identifier for the target chat or username of the target channel (in the format @channelusername)
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Unique identifier for the target message thread (topic) of the forum; for forum supergroups only
 	MessageThreadId int64 `json:"message_thread_id,omitempty"`
@@ -1,5 +1,10 @@
 package types
 
+import (
+	"encoding/json"
+	"reflect"
+)
+
 // This object represents an incoming update.At most one of the optional parameters can be present in any given update.
 // 
 // https://core.telegram.org/bots/api#update
@@ -79,6 +84,142 @@ type Update struct {
 	
 }
 
+// UpdateKind тип обновления, совпадает с именем поля в Update и значением в allowed_updates
+type UpdateKind string
+
+// Типы обновлений
+const (
+	UpdateKindMessage UpdateKind = "message"
+	UpdateKindEditedMessage UpdateKind = "edited_message"
+	UpdateKindChannelPost UpdateKind = "channel_post"
+	UpdateKindEditedChannelPost UpdateKind = "edited_channel_post"
+	UpdateKindBusinessConnection UpdateKind = "business_connection"
+	UpdateKindBusinessMessage UpdateKind = "business_message"
+	UpdateKindEditedBusinessMessage UpdateKind = "edited_business_message"
+	UpdateKindDeletedBusinessMessages UpdateKind = "deleted_business_messages"
+	UpdateKindMessageReaction UpdateKind = "message_reaction"
+	UpdateKindMessageReactionCount UpdateKind = "message_reaction_count"
+	UpdateKindInlineQuery UpdateKind = "inline_query"
+	UpdateKindChosenInlineResult UpdateKind = "chosen_inline_result"
+	UpdateKindCallbackQuery UpdateKind = "callback_query"
+	UpdateKindShippingQuery UpdateKind = "shipping_query"
+	UpdateKindPreCheckoutQuery UpdateKind = "pre_checkout_query"
+	UpdateKindPurchasedPaidMedia UpdateKind = "purchased_paid_media"
+	UpdateKindPoll UpdateKind = "poll"
+	UpdateKindPollAnswer UpdateKind = "poll_answer"
+	UpdateKindMyChatMember UpdateKind = "my_chat_member"
+	UpdateKindChatMember UpdateKind = "chat_member"
+	UpdateKindChatJoinRequest UpdateKind = "chat_join_request"
+	UpdateKindChatBoost UpdateKind = "chat_boost"
+	UpdateKindRemovedChatBoost UpdateKind = "removed_chat_boost"
+)
+
+// AllUpdateKinds все типы обновлений
+var AllUpdateKinds = []UpdateKind{
+	UpdateKindMessage,
+	UpdateKindEditedMessage,
+	UpdateKindChannelPost,
+	UpdateKindEditedChannelPost,
+	UpdateKindBusinessConnection,
+	UpdateKindBusinessMessage,
+	UpdateKindEditedBusinessMessage,
+	UpdateKindDeletedBusinessMessages,
+	UpdateKindMessageReaction,
+	UpdateKindMessageReactionCount,
+	UpdateKindInlineQuery,
+	UpdateKindChosenInlineResult,
+	UpdateKindCallbackQuery,
+	UpdateKindShippingQuery,
+	UpdateKindPreCheckoutQuery,
+	UpdateKindPurchasedPaidMedia,
+	UpdateKindPoll,
+	UpdateKindPollAnswer,
+	UpdateKindMyChatMember,
+	UpdateKindChatMember,
+	UpdateKindChatJoinRequest,
+	UpdateKindChatBoost,
+	UpdateKindRemovedChatBoost,
+}
+
+// DefaultUpdateKinds типы обновлений, которые Telegram присылает, если allowed_updates ещё ни разу не задавался.
+// Пустой allowed_updates не сбрасывает список к этим типам: Telegram продолжает использовать предыдущее значение.
+var DefaultUpdateKinds = []UpdateKind{
+	UpdateKindMessage,
+	UpdateKindEditedMessage,
+	UpdateKindChannelPost,
+	UpdateKindEditedChannelPost,
+	UpdateKindBusinessConnection,
+	UpdateKindBusinessMessage,
+	UpdateKindEditedBusinessMessage,
+	UpdateKindDeletedBusinessMessages,
+	UpdateKindInlineQuery,
+	UpdateKindChosenInlineResult,
+	UpdateKindCallbackQuery,
+	UpdateKindShippingQuery,
+	UpdateKindPreCheckoutQuery,
+	UpdateKindPurchasedPaidMedia,
+	UpdateKindPoll,
+	UpdateKindPollAnswer,
+	UpdateKindMyChatMember,
+	UpdateKindChatJoinRequest,
+	UpdateKindChatBoost,
+	UpdateKindRemovedChatBoost,
+}
+
+// Kind метод определения типа обновления
+func (u Update) Kind() UpdateKind {
+	switch {
+	case u.Message != nil:
+		return UpdateKindMessage
+	case u.EditedMessage != nil:
+		return UpdateKindEditedMessage
+	case u.ChannelPost != nil:
+		return UpdateKindChannelPost
+	case u.EditedChannelPost != nil:
+		return UpdateKindEditedChannelPost
+	case u.BusinessConnection != nil:
+		return UpdateKindBusinessConnection
+	case u.BusinessMessage != nil:
+		return UpdateKindBusinessMessage
+	case u.EditedBusinessMessage != nil:
+		return UpdateKindEditedBusinessMessage
+	case u.DeletedBusinessMessages != nil:
+		return UpdateKindDeletedBusinessMessages
+	case u.MessageReaction != nil:
+		return UpdateKindMessageReaction
+	case u.MessageReactionCount != nil:
+		return UpdateKindMessageReactionCount
+	case u.InlineQuery != nil:
+		return UpdateKindInlineQuery
+	case u.ChosenInlineResult != nil:
+		return UpdateKindChosenInlineResult
+	case u.CallbackQuery != nil:
+		return UpdateKindCallbackQuery
+	case u.ShippingQuery != nil:
+		return UpdateKindShippingQuery
+	case u.PreCheckoutQuery != nil:
+		return UpdateKindPreCheckoutQuery
+	case u.PurchasedPaidMedia != nil:
+		return UpdateKindPurchasedPaidMedia
+	case u.Poll != nil:
+		return UpdateKindPoll
+	case u.PollAnswer != nil:
+		return UpdateKindPollAnswer
+	case u.MyChatMember != nil:
+		return UpdateKindMyChatMember
+	case u.ChatMember != nil:
+		return UpdateKindChatMember
+	case u.ChatJoinRequest != nil:
+		return UpdateKindChatJoinRequest
+	case u.ChatBoost != nil:
+		return UpdateKindChatBoost
+	case u.RemovedChatBoost != nil:
+		return UpdateKindRemovedChatBoost
+	}
+
+	return ""
+}
+
 
 // Describes the current status of a webhook.
 // 
@@ -342,6 +483,26 @@ type ChatFullInfo struct {
 	
 }
 
+// UnmarshalJSON метод десериализации ChatFullInfo с полями-объединениями
+func (v *ChatFullInfo) UnmarshalJSON(data []byte) error {
+	type alias ChatFullInfo
+	aux := struct {
+		*alias
+		AvailableReactions []json.RawMessage `json:"available_reactions"`
+	}{alias: (*alias)(v)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var err error
+	if v.AvailableReactions, err = unmarshalSlice(aux.AvailableReactions, unmarshalReactionType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 
 // This object represents a message.
 // 
@@ -379,7 +540,7 @@ type Message struct {
 	Chat *Chat `json:"chat"`
 	
 	// Optional. Information about the original message for forwarded messages
-	ForwardOrigin *MessageOrigin `json:"forward_origin,omitempty"`
+	ForwardOrigin MessageOrigin `json:"forward_origin,omitempty"`
 	
 	// Optional. True, if the message is sent to a forum topic
 	IsTopicMessage bool `json:"is_topic_message,omitempty"`
@@ -538,7 +699,7 @@ type Message struct {
 	MigrateFromChatId int64 `json:"migrate_from_chat_id,omitempty"`
 	
 	// Optional. Specified message was pinned. Note that the Message object in this field will not contain further reply_to_message fields even if it itself is a reply.
-	PinnedMessage *MaybeInaccessibleMessage `json:"pinned_message,omitempty"`
+	PinnedMessage MaybeInaccessibleMessage `json:"pinned_message,omitempty"`
 	
 	// Optional. Message is an invoice for a payment, information about the invoice. More about payments »
 	Invoice *Invoice `json:"invoice,omitempty"`
@@ -656,6 +817,32 @@ type Message struct {
 	
 }
 
+func (Message) isMaybeInaccessibleMessage() {}
+
+// UnmarshalJSON метод десериализации Message с полями-объединениями
+func (v *Message) UnmarshalJSON(data []byte) error {
+	type alias Message
+	aux := struct {
+		*alias
+		ForwardOrigin json.RawMessage `json:"forward_origin"`
+		PinnedMessage json.RawMessage `json:"pinned_message"`
+	}{alias: (*alias)(v)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var err error
+	if v.ForwardOrigin, err = unmarshalMessageOrigin(aux.ForwardOrigin); err != nil {
+		return err
+	}
+	if v.PinnedMessage, err = unmarshalMaybeInaccessibleMessage(aux.PinnedMessage); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 
 // This object represents a unique message identifier.
 // 
@@ -684,16 +871,45 @@ type InaccessibleMessage struct {
 	
 }
 
+func (InaccessibleMessage) isMaybeInaccessibleMessage() {}
+
 
 // This object describes a message that can be inaccessible to the bot. It can be one of
 //  - Message
 //  - InaccessibleMessage
 // 
-//
-// Use types.CastTo[*type*](*data*)
-//
 // https://core.telegram.org/bots/api#maybeinaccessiblemessage
-type MaybeInaccessibleMessage map[string] any
+type MaybeInaccessibleMessage interface {
+	isMaybeInaccessibleMessage()
+}
+
+// unmarshalMaybeInaccessibleMessage функция десериализации MaybeInaccessibleMessage по значению поля date
+func unmarshalMaybeInaccessibleMessage(data []byte) (MaybeInaccessibleMessage, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	var d struct {
+		Value json.RawMessage `json:"date"`
+	}
+	if err := json.Unmarshal(data, &d); err != nil {
+		return nil, err
+	}
+
+	var v MaybeInaccessibleMessage
+	switch string(d.Value) {
+	case `0`:
+		v = new(InaccessibleMessage)
+	default:
+		v = new(Message)
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
 
 
 // This object represents one special entity in a text message. For example, hashtags, usernames, URLs, etc.
@@ -751,7 +967,7 @@ type TextQuote struct {
 type ExternalReplyInfo struct {
 	
 	// Origin of the message replied to by the given message
-	Origin *MessageOrigin `json:"origin"`
+	Origin MessageOrigin `json:"origin"`
 	
 	// Optional. Chat the original message belongs to. Available only if the chat is a supergroup or a channel.
 	Chat *Chat `json:"chat,omitempty"`
@@ -827,6 +1043,26 @@ type ExternalReplyInfo struct {
 	
 }
 
+// UnmarshalJSON метод десериализации ExternalReplyInfo с полями-объединениями
+func (v *ExternalReplyInfo) UnmarshalJSON(data []byte) error {
+	type alias ExternalReplyInfo
+	aux := struct {
+		*alias
+		Origin json.RawMessage `json:"origin"`
+	}{alias: (*alias)(v)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var err error
+	if v.Origin, err = unmarshalMessageOrigin(aux.Origin); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 
 // Describes reply parameters for the message that is being sent.
 // 
@@ -837,7 +1073,7 @@ type ReplyParameters struct {
 	MessageId int64 `json:"message_id"`
 	
 	// Optional. If the message to be replied to is from a different chat, unique identifier for the chat or username of the channel (in the format @channelusername). Not supported for messages sent on behalf of a business account and messages from channel direct messages chats.
-	ChatId int64 `json:"chat_id,omitempty"`
+	ChatId ChatID `json:"chat_id,omitempty"`
 	
 	// Optional. Pass True if the message should be sent even if the specified message to be replied to is not found. Always False for replies in another chat or forum topic. Always True for messages sent on behalf of a business account.
 	AllowSendingWithoutReply bool `json:"allow_sending_without_reply,omitempty"`
@@ -866,11 +1102,44 @@ type ReplyParameters struct {
 //  - MessageOriginChat
 //  - MessageOriginChannel
 // 
-//
-// Use types.CastTo[*type*](*data*)
-//
 // https://core.telegram.org/bots/api#messageorigin
-type MessageOrigin map[string] any
+type MessageOrigin interface {
+	isMessageOrigin()
+}
+
+// unmarshalMessageOrigin функция десериализации MessageOrigin по значению поля type
+func unmarshalMessageOrigin(data []byte) (MessageOrigin, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	var d struct {
+		Value json.RawMessage `json:"type"`
+	}
+	if err := json.Unmarshal(data, &d); err != nil {
+		return nil, err
+	}
+
+	var v MessageOrigin
+	switch string(d.Value) {
+	case `"user"`:
+		v = new(MessageOriginUser)
+	case `"hidden_user"`:
+		v = new(MessageOriginHiddenUser)
+	case `"chat"`:
+		v = new(MessageOriginChat)
+	case `"channel"`:
+		v = new(MessageOriginChannel)
+	default:
+		return nil, nil
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
 
 
 // The message was originally sent by a known user.
@@ -889,6 +1158,16 @@ type MessageOriginUser struct {
 	
 }
 
+func (MessageOriginUser) isMessageOrigin() {}
+
+// MarshalJSON метод сериализации MessageOriginUser с заполненным полем Type
+func (v MessageOriginUser) MarshalJSON() ([]byte, error) {
+	type alias MessageOriginUser
+	a := alias(v)
+	a.Type = "user"
+	return json.Marshal(a)
+}
+
 
 // The message was originally sent by an unknown user.
 // 
@@ -906,6 +1185,16 @@ type MessageOriginHiddenUser struct {
 	
 }
 
+func (MessageOriginHiddenUser) isMessageOrigin() {}
+
+// MarshalJSON метод сериализации MessageOriginHiddenUser с заполненным полем Type
+func (v MessageOriginHiddenUser) MarshalJSON() ([]byte, error) {
+	type alias MessageOriginHiddenUser
+	a := alias(v)
+	a.Type = "hidden_user"
+	return json.Marshal(a)
+}
+
 
 // The message was originally sent on behalf of a chat to a group chat.
 // 
@@ -926,6 +1215,16 @@ type MessageOriginChat struct {
 	
 }
 
+func (MessageOriginChat) isMessageOrigin() {}
+
+// MarshalJSON метод сериализации MessageOriginChat с заполненным полем Type
+func (v MessageOriginChat) MarshalJSON() ([]byte, error) {
+	type alias MessageOriginChat
+	a := alias(v)
+	a.Type = "chat"
+	return json.Marshal(a)
+}
+
 
 // The message was originally sent to a channel chat.
 // 
@@ -949,6 +1248,16 @@ type MessageOriginChannel struct {
 	
 }
 
+func (MessageOriginChannel) isMessageOrigin() {}
+
+// MarshalJSON метод сериализации MessageOriginChannel с заполненным полем Type
+func (v MessageOriginChannel) MarshalJSON() ([]byte, error) {
+	type alias MessageOriginChannel
+	a := alias(v)
+	a.Type = "channel"
+	return json.Marshal(a)
+}
+
 
 // This object represents one size of a photo or a file / sticker thumbnail.
 // 
@@ -1186,17 +1495,68 @@ type PaidMediaInfo struct {
 	
 }
 
+// UnmarshalJSON метод десериализации PaidMediaInfo с полями-объединениями
+func (v *PaidMediaInfo) UnmarshalJSON(data []byte) error {
+	type alias PaidMediaInfo
+	aux := struct {
+		*alias
+		PaidMedia []json.RawMessage `json:"paid_media"`
+	}{alias: (*alias)(v)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var err error
+	if v.PaidMedia, err = unmarshalSlice(aux.PaidMedia, unmarshalPaidMedia); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 
 // This object describes paid media. Currently, it can be one of
 //  - PaidMediaPreview
 //  - PaidMediaPhoto
 //  - PaidMediaVideo
 // 
-//
-// Use types.CastTo[*type*](*data*)
-//
 // https://core.telegram.org/bots/api#paidmedia
-type PaidMedia map[string] any
+type PaidMedia interface {
+	isPaidMedia()
+}
+
+// unmarshalPaidMedia функция десериализации PaidMedia по значению поля type
+func unmarshalPaidMedia(data []byte) (PaidMedia, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	var d struct {
+		Value json.RawMessage `json:"type"`
+	}
+	if err := json.Unmarshal(data, &d); err != nil {
+		return nil, err
+	}
+
+	var v PaidMedia
+	switch string(d.Value) {
+	case `"preview"`:
+		v = new(PaidMediaPreview)
+	case `"photo"`:
+		v = new(PaidMediaPhoto)
+	case `"video"`:
+		v = new(PaidMediaVideo)
+	default:
+		return nil, nil
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
 
 
 // The paid media isn&#39;t available before the payment.
@@ -1218,6 +1578,16 @@ type PaidMediaPreview struct {
 	
 }
 
+func (PaidMediaPreview) isPaidMedia() {}
+
+// MarshalJSON метод сериализации PaidMediaPreview с заполненным полем Type
+func (v PaidMediaPreview) MarshalJSON() ([]byte, error) {
+	type alias PaidMediaPreview
+	a := alias(v)
+	a.Type = "preview"
+	return json.Marshal(a)
+}
+
 
 // The paid media is a photo.
 // 
@@ -1232,6 +1602,16 @@ type PaidMediaPhoto struct {
 	
 }
 
+func (PaidMediaPhoto) isPaidMedia() {}
+
+// MarshalJSON метод сериализации PaidMediaPhoto с заполненным полем Type
+func (v PaidMediaPhoto) MarshalJSON() ([]byte, error) {
+	type alias PaidMediaPhoto
+	a := alias(v)
+	a.Type = "photo"
+	return json.Marshal(a)
+}
+
 
 // The paid media is a video.
 // 
@@ -1246,6 +1626,16 @@ type PaidMediaVideo struct {
 	
 }
 
+func (PaidMediaVideo) isPaidMedia() {}
+
+// MarshalJSON метод сериализации PaidMediaVideo с заполненным полем Type
+func (v PaidMediaVideo) MarshalJSON() ([]byte, error) {
+	type alias PaidMediaVideo
+	a := alias(v)
+	a.Type = "video"
+	return json.Marshal(a)
+}
+
 
 // This object represents a phone contact.
 // 
@@ -1624,11 +2014,42 @@ type ChatBoostAdded struct {
 //  - BackgroundFillGradient
 //  - BackgroundFillFreeformGradient
 // 
-//
-// Use types.CastTo[*type*](*data*)
-//
 // https://core.telegram.org/bots/api#backgroundfill
-type BackgroundFill map[string] any
+type BackgroundFill interface {
+	isBackgroundFill()
+}
+
+// unmarshalBackgroundFill функция десериализации BackgroundFill по значению поля type
+func unmarshalBackgroundFill(data []byte) (BackgroundFill, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	var d struct {
+		Value json.RawMessage `json:"type"`
+	}
+	if err := json.Unmarshal(data, &d); err != nil {
+		return nil, err
+	}
+
+	var v BackgroundFill
+	switch string(d.Value) {
+	case `"solid"`:
+		v = new(BackgroundFillSolid)
+	case `"gradient"`:
+		v = new(BackgroundFillGradient)
+	case `"freeform_gradient"`:
+		v = new(BackgroundFillFreeformGradient)
+	default:
+		return nil, nil
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
 
 
 // The background is filled using the selected color.
@@ -1644,6 +2065,16 @@ type BackgroundFillSolid struct {
 	
 }
 
+func (BackgroundFillSolid) isBackgroundFill() {}
+
+// MarshalJSON метод сериализации BackgroundFillSolid с заполненным полем Type
+func (v BackgroundFillSolid) MarshalJSON() ([]byte, error) {
+	type alias BackgroundFillSolid
+	a := alias(v)
+	a.Type = "solid"
+	return json.Marshal(a)
+}
+
 
 // The background is a gradient fill.
 // 
@@ -1664,6 +2095,16 @@ type BackgroundFillGradient struct {
 	
 }
 
+func (BackgroundFillGradient) isBackgroundFill() {}
+
+// MarshalJSON метод сериализации BackgroundFillGradient с заполненным полем Type
+func (v BackgroundFillGradient) MarshalJSON() ([]byte, error) {
+	type alias BackgroundFillGradient
+	a := alias(v)
+	a.Type = "gradient"
+	return json.Marshal(a)
+}
+
 
 // The background is a freeform gradient that rotates after every message in the chat.
 // 
@@ -1678,6 +2119,16 @@ type BackgroundFillFreeformGradient struct {
 	
 }
 
+func (BackgroundFillFreeformGradient) isBackgroundFill() {}
+
+// MarshalJSON метод сериализации BackgroundFillFreeformGradient с заполненным полем Type
+func (v BackgroundFillFreeformGradient) MarshalJSON() ([]byte, error) {
+	type alias BackgroundFillFreeformGradient
+	a := alias(v)
+	a.Type = "freeform_gradient"
+	return json.Marshal(a)
+}
+
 
 // This object describes the type of a background. Currently, it can be one of
 //  - BackgroundTypeFill
@@ -1685,11 +2136,44 @@ type BackgroundFillFreeformGradient struct {
 //  - BackgroundTypePattern
 //  - BackgroundTypeChatTheme
 // 
-//
-// Use types.CastTo[*type*](*data*)
-//
 // https://core.telegram.org/bots/api#backgroundtype
-type BackgroundType map[string] any
+type BackgroundType interface {
+	isBackgroundType()
+}
+
+// unmarshalBackgroundType функция десериализации BackgroundType по значению поля type
+func unmarshalBackgroundType(data []byte) (BackgroundType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	var d struct {
+		Value json.RawMessage `json:"type"`
+	}
+	if err := json.Unmarshal(data, &d); err != nil {
+		return nil, err
+	}
+
+	var v BackgroundType
+	switch string(d.Value) {
+	case `"fill"`:
+		v = new(BackgroundTypeFill)
+	case `"wallpaper"`:
+		v = new(BackgroundTypeWallpaper)
+	case `"pattern"`:
+		v = new(BackgroundTypePattern)
+	case `"chat_theme"`:
+		v = new(BackgroundTypeChatTheme)
+	default:
+		return nil, nil
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
 
 
 // The background is automatically filled based on the selected colors.
@@ -1701,13 +2185,43 @@ type BackgroundTypeFill struct {
 	Type string `json:"type"`
 	
 	// The background fill
-	Fill *BackgroundFill `json:"fill"`
+	Fill BackgroundFill `json:"fill"`
 	
 	// Dimming of the background in dark themes, as a percentage; 0-100
 	DarkThemeDimming int64 `json:"dark_theme_dimming"`
 	
 }
 
+func (BackgroundTypeFill) isBackgroundType() {}
+
+// MarshalJSON метод сериализации BackgroundTypeFill с заполненным полем Type
+func (v BackgroundTypeFill) MarshalJSON() ([]byte, error) {
+	type alias BackgroundTypeFill
+	a := alias(v)
+	a.Type = "fill"
+	return json.Marshal(a)
+}
+
+// UnmarshalJSON метод десериализации BackgroundTypeFill с полями-объединениями
+func (v *BackgroundTypeFill) UnmarshalJSON(data []byte) error {
+	type alias BackgroundTypeFill
+	aux := struct {
+		*alias
+		Fill json.RawMessage `json:"fill"`
+	}{alias: (*alias)(v)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var err error
+	if v.Fill, err = unmarshalBackgroundFill(aux.Fill); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 
 // The background is a wallpaper in the JPEG format.
 // 
@@ -1731,6 +2245,16 @@ type BackgroundTypeWallpaper struct {
 	
 }
 
+func (BackgroundTypeWallpaper) isBackgroundType() {}
+
+// MarshalJSON метод сериализации BackgroundTypeWallpaper с заполненным полем Type
+func (v BackgroundTypeWallpaper) MarshalJSON() ([]byte, error) {
+	type alias BackgroundTypeWallpaper
+	a := alias(v)
+	a.Type = "wallpaper"
+	return json.Marshal(a)
+}
+
 
 // The background is a .PNG or .TGV (gzipped subset of SVG with MIME type “application/x-tgwallpattern”) pattern to be combined with the background fill chosen by the user.
 // 
@@ -1744,7 +2268,7 @@ type BackgroundTypePattern struct {
 	Document *Document `json:"document"`
 	
 	// The background fill that is combined with the pattern
-	Fill *BackgroundFill `json:"fill"`
+	Fill BackgroundFill `json:"fill"`
 	
 	// Intensity of the pattern when it is shown above the filled background; 0-100
 	Intensity int64 `json:"intensity"`
@@ -1757,6 +2281,36 @@ type BackgroundTypePattern struct {
 	
 }
 
+func (BackgroundTypePattern) isBackgroundType() {}
+
+// MarshalJSON метод сериализации BackgroundTypePattern с заполненным полем Type
+func (v BackgroundTypePattern) MarshalJSON() ([]byte, error) {
+	type alias BackgroundTypePattern
+	a := alias(v)
+	a.Type = "pattern"
+	return json.Marshal(a)
+}
+
+// UnmarshalJSON метод десериализации BackgroundTypePattern с полями-объединениями
+func (v *BackgroundTypePattern) UnmarshalJSON(data []byte) error {
+	type alias BackgroundTypePattern
+	aux := struct {
+		*alias
+		Fill json.RawMessage `json:"fill"`
+	}{alias: (*alias)(v)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var err error
+	if v.Fill, err = unmarshalBackgroundFill(aux.Fill); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 
 // The background is taken directly from a built-in chat theme.
 // 
@@ -1771,6 +2325,16 @@ type BackgroundTypeChatTheme struct {
 	
 }
 
+func (BackgroundTypeChatTheme) isBackgroundType() {}
+
+// MarshalJSON метод сериализации BackgroundTypeChatTheme с заполненным полем Type
+func (v BackgroundTypeChatTheme) MarshalJSON() ([]byte, error) {
+	type alias BackgroundTypeChatTheme
+	a := alias(v)
+	a.Type = "chat_theme"
+	return json.Marshal(a)
+}
+
 
 // This object represents a chat background.
 // 
@@ -1778,10 +2342,30 @@ type BackgroundTypeChatTheme struct {
 type ChatBackground struct {
 	
 	// Type of the background
-	Type *BackgroundType `json:"type"`
+	Type BackgroundType `json:"type"`
 	
 }
 
+// UnmarshalJSON метод десериализации ChatBackground с полями-объединениями
+func (v *ChatBackground) UnmarshalJSON(data []byte) error {
+	type alias ChatBackground
+	aux := struct {
+		*alias
+		Type json.RawMessage `json:"type"`
+	}{alias: (*alias)(v)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var err error
+	if v.Type, err = unmarshalBackgroundType(aux.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 
 // This object represents a service message about a new forum topic created in the chat.
 // 
@@ -1804,7 +2388,7 @@ type ForumTopicCreated struct {
 // 
 // https://core.telegram.org/bots/api#forumtopicclosed
 type ForumTopicClosed struct {
-	  
+	 
 }
 
 
@@ -1826,7 +2410,7 @@ type ForumTopicEdited struct {
 // 
 // https://core.telegram.org/bots/api#forumtopicreopened
 type ForumTopicReopened struct {
-	  
+	 
 }
 
 
@@ -1834,7 +2418,7 @@ type ForumTopicReopened struct {
 // 
 // https://core.telegram.org/bots/api#generalforumtopichidden
 type GeneralForumTopicHidden struct {
-	  
+	 
 }
 
 
@@ -1842,7 +2426,7 @@ type GeneralForumTopicHidden struct {
 // 
 // https://core.telegram.org/bots/api#generalforumtopicunhidden
 type GeneralForumTopicUnhidden struct {
-	  
+	 
 }
 
 
@@ -1938,7 +2522,7 @@ type VideoChatScheduled struct {
 // 
 // https://core.telegram.org/bots/api#videochatstarted
 type VideoChatStarted struct {
-	  
+	 
 }
 
 
@@ -2579,7 +3163,7 @@ type CallbackQuery struct {
 	From *User `json:"from"`
 	
 	// Optional. Message sent by the bot with the callback button that originated the query
-	Message *MaybeInaccessibleMessage `json:"message,omitempty"`
+	Message MaybeInaccessibleMessage `json:"message,omitempty"`
 	
 	// Optional. Identifier of the message sent via the bot in inline mode, that originated the query.
 	InlineMessageId string `json:"inline_message_id,omitempty"`
@@ -2595,6 +3179,26 @@ type CallbackQuery struct {
 	
 }
 
+// UnmarshalJSON метод десериализации CallbackQuery с полями-объединениями
+func (v *CallbackQuery) UnmarshalJSON(data []byte) error {
+	type alias CallbackQuery
+	aux := struct {
+		*alias
+		Message json.RawMessage `json:"message"`
+	}{alias: (*alias)(v)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var err error
+	if v.Message, err = unmarshalMaybeInaccessibleMessage(aux.Message); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 
 // Upon receiving a message with this object, Telegram clients will display a reply interface to the user (act as if the user has selected the bot&#39;s message and tapped &#39;Reply&#39;). This can be extremely useful if you want to create user-friendly step-by-step interfaces without having to sacrifice privacy mode. Not supported in channels and for messages sent on behalf of a Telegram Business account.
 //  - Explain the user how to send a command with parameters (e.g. /newpoll question answer1 answer2). May be appealing for hardcore users but lacks modern day polish.
@@ -2749,10 +3353,10 @@ type ChatMemberUpdated struct {
 	Date int64 `json:"date"`
 	
 	// Previous information about the chat member
-	OldChatMember *ChatMember `json:"old_chat_member"`
+	OldChatMember ChatMember `json:"old_chat_member"`
 	
 	// New information about the chat member
-	NewChatMember *ChatMember `json:"new_chat_member"`
+	NewChatMember ChatMember `json:"new_chat_member"`
 	
 	// Optional. Chat invite link, which was used by the user to join the chat; for joining by invite link events only.
 	InviteLink *ChatInviteLink `json:"invite_link,omitempty"`
@@ -2765,6 +3369,30 @@ type ChatMemberUpdated struct {
 	
 }
 
+// UnmarshalJSON метод десериализации ChatMemberUpdated с полями-объединениями
+func (v *ChatMemberUpdated) UnmarshalJSON(data []byte) error {
+	type alias ChatMemberUpdated
+	aux := struct {
+		*alias
+		OldChatMember json.RawMessage `json:"old_chat_member"`
+		NewChatMember json.RawMessage `json:"new_chat_member"`
+	}{alias: (*alias)(v)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var err error
+	if v.OldChatMember, err = unmarshalChatMember(aux.OldChatMember); err != nil {
+		return err
+	}
+	if v.NewChatMember, err = unmarshalChatMember(aux.NewChatMember); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 
 // This object contains information about one member of a chat. Currently, the following 6 types of chat members are supported:
 //  - ChatMemberOwner
@@ -2774,11 +3402,48 @@ type ChatMemberUpdated struct {
 //  - ChatMemberLeft
 //  - ChatMemberBanned
 // 
-//
-// Use types.CastTo[*type*](*data*)
-//
 // https://core.telegram.org/bots/api#chatmember
-type ChatMember map[string] any
+type ChatMember interface {
+	isChatMember()
+}
+
+// unmarshalChatMember функция десериализации ChatMember по значению поля status
+func unmarshalChatMember(data []byte) (ChatMember, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	var d struct {
+		Value json.RawMessage `json:"status"`
+	}
+	if err := json.Unmarshal(data, &d); err != nil {
+		return nil, err
+	}
+
+	var v ChatMember
+	switch string(d.Value) {
+	case `"creator"`:
+		v = new(ChatMemberOwner)
+	case `"administrator"`:
+		v = new(ChatMemberAdministrator)
+	case `"member"`:
+		v = new(ChatMemberMember)
+	case `"restricted"`:
+		v = new(ChatMemberRestricted)
+	case `"left"`:
+		v = new(ChatMemberLeft)
+	case `"kicked"`:
+		v = new(ChatMemberBanned)
+	default:
+		return nil, nil
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
 
 
 // Represents a chat member that owns the chat and has all administrator privileges.
@@ -2800,6 +3465,16 @@ type ChatMemberOwner struct {
 	
 }
 
+func (ChatMemberOwner) isChatMember() {}
+
+// MarshalJSON метод сериализации ChatMemberOwner с заполненным полем Status
+func (v ChatMemberOwner) MarshalJSON() ([]byte, error) {
+	type alias ChatMemberOwner
+	a := alias(v)
+	a.Status = "creator"
+	return json.Marshal(a)
+}
+
 
 // Represents a chat member that has some additional privileges.
 // 
@@ -2868,6 +3543,16 @@ type ChatMemberAdministrator struct {
 	
 }
 
+func (ChatMemberAdministrator) isChatMember() {}
+
+// MarshalJSON метод сериализации ChatMemberAdministrator с заполненным полем Status
+func (v ChatMemberAdministrator) MarshalJSON() ([]byte, error) {
+	type alias ChatMemberAdministrator
+	a := alias(v)
+	a.Status = "administrator"
+	return json.Marshal(a)
+}
+
 
 // Represents a chat member that has no additional privileges or restrictions.
 // 
@@ -2885,6 +3570,16 @@ type ChatMemberMember struct {
 	
 }
 
+func (ChatMemberMember) isChatMember() {}
+
+// MarshalJSON метод сериализации ChatMemberMember с заполненным полем Status
+func (v ChatMemberMember) MarshalJSON() ([]byte, error) {
+	type alias ChatMemberMember
+	a := alias(v)
+	a.Status = "member"
+	return json.Marshal(a)
+}
+
 
 // Represents a chat member that is under certain restrictions in the chat. Supergroups only.
 // 
@@ -2947,6 +3642,16 @@ type ChatMemberRestricted struct {
 	
 }
 
+func (ChatMemberRestricted) isChatMember() {}
+
+// MarshalJSON метод сериализации ChatMemberRestricted с заполненным полем Status
+func (v ChatMemberRestricted) MarshalJSON() ([]byte, error) {
+	type alias ChatMemberRestricted
+	a := alias(v)
+	a.Status = "restricted"
+	return json.Marshal(a)
+}
+
 
 // Represents a chat member that isn&#39;t currently a member of the chat, but may join it themselves.
 // 
@@ -2961,6 +3666,16 @@ type ChatMemberLeft struct {
 	
 }
 
+func (ChatMemberLeft) isChatMember() {}
+
+// MarshalJSON метод сериализации ChatMemberLeft с заполненным полем Status
+func (v ChatMemberLeft) MarshalJSON() ([]byte, error) {
+	type alias ChatMemberLeft
+	a := alias(v)
+	a.Status = "left"
+	return json.Marshal(a)
+}
+
 
 // Represents a chat member that was banned in the chat and can&#39;t return to the chat or view chat messages.
 // 
@@ -2978,6 +3693,16 @@ type ChatMemberBanned struct {
 	
 }
 
+func (ChatMemberBanned) isChatMember() {}
+
+// MarshalJSON метод сериализации ChatMemberBanned с заполненным полем Status
+func (v ChatMemberBanned) MarshalJSON() ([]byte, error) {
+	type alias ChatMemberBanned
+	a := alias(v)
+	a.Status = "kicked"
+	return json.Marshal(a)
+}
+
 
 // Represents a join request sent to a chat.
 // 
@@ -3184,11 +3909,46 @@ type LocationAddress struct {
 //  - StoryAreaTypeWeather
 //  - StoryAreaTypeUniqueGift
 // 
-//
-// Use types.CastTo[*type*](*data*)
-//
 // https://core.telegram.org/bots/api#storyareatype
-type StoryAreaType map[string] any
+type StoryAreaType interface {
+	isStoryAreaType()
+}
+
+// unmarshalStoryAreaType функция десериализации StoryAreaType по значению поля type
+func unmarshalStoryAreaType(data []byte) (StoryAreaType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	var d struct {
+		Value json.RawMessage `json:"type"`
+	}
+	if err := json.Unmarshal(data, &d); err != nil {
+		return nil, err
+	}
+
+	var v StoryAreaType
+	switch string(d.Value) {
+	case `"location"`:
+		v = new(StoryAreaTypeLocation)
+	case `"suggested_reaction"`:
+		v = new(StoryAreaTypeSuggestedReaction)
+	case `"link"`:
+		v = new(StoryAreaTypeLink)
+	case `"weather"`:
+		v = new(StoryAreaTypeWeather)
+	case `"unique_gift"`:
+		v = new(StoryAreaTypeUniqueGift)
+	default:
+		return nil, nil
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
 
 
 // Describes a story area pointing to a location. Currently, a story can have up to 10 location areas.
@@ -3210,6 +3970,16 @@ type StoryAreaTypeLocation struct {
 	
 }
 
+func (StoryAreaTypeLocation) isStoryAreaType() {}
+
+// MarshalJSON метод сериализации StoryAreaTypeLocation с заполненным полем Type
+func (v StoryAreaTypeLocation) MarshalJSON() ([]byte, error) {
+	type alias StoryAreaTypeLocation
+	a := alias(v)
+	a.Type = "location"
+	return json.Marshal(a)
+}
+
 
 // Describes a story area pointing to a suggested reaction. Currently, a story can have up to 5 suggested reaction areas.
 // 
@@ -3220,7 +3990,7 @@ type StoryAreaTypeSuggestedReaction struct {
 	Type string `json:"type"`
 	
 	// Type of the reaction
-	ReactionType *ReactionType `json:"reaction_type"`
+	ReactionType ReactionType `json:"reaction_type"`
 	
 	// Optional. Pass True if the reaction area has a dark background
 	IsDark bool `json:"is_dark,omitempty"`
@@ -3230,6 +4000,36 @@ type StoryAreaTypeSuggestedReaction struct {
 	
 }
 
+func (StoryAreaTypeSuggestedReaction) isStoryAreaType() {}
+
+// MarshalJSON метод сериализации StoryAreaTypeSuggestedReaction с заполненным полем Type
+func (v StoryAreaTypeSuggestedReaction) MarshalJSON() ([]byte, error) {
+	type alias StoryAreaTypeSuggestedReaction
+	a := alias(v)
+	a.Type = "suggested_reaction"
+	return json.Marshal(a)
+}
+
+// UnmarshalJSON метод десериализации StoryAreaTypeSuggestedReaction с полями-объединениями
+func (v *StoryAreaTypeSuggestedReaction) UnmarshalJSON(data []byte) error {
+	type alias StoryAreaTypeSuggestedReaction
+	aux := struct {
+		*alias
+		ReactionType json.RawMessage `json:"reaction_type"`
+	}{alias: (*alias)(v)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var err error
+	if v.ReactionType, err = unmarshalReactionType(aux.ReactionType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 
 // Describes a story area pointing to an HTTP or tg:// link. Currently, a story can have up to 3 link areas.
 // 
@@ -3244,6 +4044,16 @@ type StoryAreaTypeLink struct {
 	
 }
 
+func (StoryAreaTypeLink) isStoryAreaType() {}
+
+// MarshalJSON метод сериализации StoryAreaTypeLink с заполненным полем Type
+func (v StoryAreaTypeLink) MarshalJSON() ([]byte, error) {
+	type alias StoryAreaTypeLink
+	a := alias(v)
+	a.Type = "link"
+	return json.Marshal(a)
+}
+
 
 // Describes a story area containing weather information. Currently, a story can have up to 3 weather areas.
 // 
@@ -3264,6 +4074,16 @@ type StoryAreaTypeWeather struct {
 	
 }
 
+func (StoryAreaTypeWeather) isStoryAreaType() {}
+
+// MarshalJSON метод сериализации StoryAreaTypeWeather с заполненным полем Type
+func (v StoryAreaTypeWeather) MarshalJSON() ([]byte, error) {
+	type alias StoryAreaTypeWeather
+	a := alias(v)
+	a.Type = "weather"
+	return json.Marshal(a)
+}
+
 
 // Describes a story area pointing to a unique gift. Currently, a story can have at most 1 unique gift area.
 // 
@@ -3278,6 +4098,16 @@ type StoryAreaTypeUniqueGift struct {
 	
 }
 
+func (StoryAreaTypeUniqueGift) isStoryAreaType() {}
+
+// MarshalJSON метод сериализации StoryAreaTypeUniqueGift с заполненным полем Type
+func (v StoryAreaTypeUniqueGift) MarshalJSON() ([]byte, error) {
+	type alias StoryAreaTypeUniqueGift
+	a := alias(v)
+	a.Type = "unique_gift"
+	return json.Marshal(a)
+}
+
 
 // Describes a clickable area on a story media.
 // 
@@ -3288,10 +4118,30 @@ type StoryArea struct {
 	Position *StoryAreaPosition `json:"position"`
 	
 	// Type of the area
-	Type *StoryAreaType `json:"type"`
+	Type StoryAreaType `json:"type"`
 	
 }
 
+// UnmarshalJSON метод десериализации StoryArea с полями-объединениями
+func (v *StoryArea) UnmarshalJSON(data []byte) error {
+	type alias StoryArea
+	aux := struct {
+		*alias
+		Type json.RawMessage `json:"type"`
+	}{alias: (*alias)(v)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var err error
+	if v.Type, err = unmarshalStoryAreaType(aux.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 
 // Represents a location to which a chat is connected.
 // 
@@ -3312,11 +4162,42 @@ type ChatLocation struct {
 //  - ReactionTypeCustomEmoji
 //  - ReactionTypePaid
 // 
-//
-// Use types.CastTo[*type*](*data*)
-//
 // https://core.telegram.org/bots/api#reactiontype
-type ReactionType map[string] any
+type ReactionType interface {
+	isReactionType()
+}
+
+// unmarshalReactionType функция десериализации ReactionType по значению поля type
+func unmarshalReactionType(data []byte) (ReactionType, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	var d struct {
+		Value json.RawMessage `json:"type"`
+	}
+	if err := json.Unmarshal(data, &d); err != nil {
+		return nil, err
+	}
+
+	var v ReactionType
+	switch string(d.Value) {
+	case `"emoji"`:
+		v = new(ReactionTypeEmoji)
+	case `"custom_emoji"`:
+		v = new(ReactionTypeCustomEmoji)
+	case `"paid"`:
+		v = new(ReactionTypePaid)
+	default:
+		return nil, nil
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
 
 
 // The reaction is based on an emoji.
@@ -3332,6 +4213,16 @@ type ReactionTypeEmoji struct {
 	
 }
 
+func (ReactionTypeEmoji) isReactionType() {}
+
+// MarshalJSON метод сериализации ReactionTypeEmoji с заполненным полем Type
+func (v ReactionTypeEmoji) MarshalJSON() ([]byte, error) {
+	type alias ReactionTypeEmoji
+	a := alias(v)
+	a.Type = "emoji"
+	return json.Marshal(a)
+}
+
 
 // The reaction is based on a custom emoji.
 // 
@@ -3346,6 +4237,16 @@ type ReactionTypeCustomEmoji struct {
 	
 }
 
+func (ReactionTypeCustomEmoji) isReactionType() {}
+
+// MarshalJSON метод сериализации ReactionTypeCustomEmoji с заполненным полем Type
+func (v ReactionTypeCustomEmoji) MarshalJSON() ([]byte, error) {
+	type alias ReactionTypeCustomEmoji
+	a := alias(v)
+	a.Type = "custom_emoji"
+	return json.Marshal(a)
+}
+
 
 // The reaction is paid.
 // 
@@ -3357,6 +4258,16 @@ type ReactionTypePaid struct {
 	
 }
 
+func (ReactionTypePaid) isReactionType() {}
+
+// MarshalJSON метод сериализации ReactionTypePaid с заполненным полем Type
+func (v ReactionTypePaid) MarshalJSON() ([]byte, error) {
+	type alias ReactionTypePaid
+	a := alias(v)
+	a.Type = "paid"
+	return json.Marshal(a)
+}
+
 
 // Represents a reaction added to a message along with the number of times it was added.
 // 
@@ -3364,13 +4275,33 @@ type ReactionTypePaid struct {
 type ReactionCount struct {
 	
 	// Type of the reaction
-	Type *ReactionType `json:"type"`
+	Type ReactionType `json:"type"`
 	
 	// Number of times the reaction was added
 	TotalCount int64 `json:"total_count"`
 	
 }
 
+// UnmarshalJSON метод десериализации ReactionCount с полями-объединениями
+func (v *ReactionCount) UnmarshalJSON(data []byte) error {
+	type alias ReactionCount
+	aux := struct {
+		*alias
+		Type json.RawMessage `json:"type"`
+	}{alias: (*alias)(v)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var err error
+	if v.Type, err = unmarshalReactionType(aux.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 
 // This object represents a change of a reaction on a message performed by a user.
 // 
@@ -3400,6 +4331,30 @@ type MessageReactionUpdated struct {
 	
 }
 
+// UnmarshalJSON метод десериализации MessageReactionUpdated с полями-объединениями
+func (v *MessageReactionUpdated) UnmarshalJSON(data []byte) error {
+	type alias MessageReactionUpdated
+	aux := struct {
+		*alias
+		OldReaction []json.RawMessage `json:"old_reaction"`
+		NewReaction []json.RawMessage `json:"new_reaction"`
+	}{alias: (*alias)(v)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var err error
+	if v.OldReaction, err = unmarshalSlice(aux.OldReaction, unmarshalReactionType); err != nil {
+		return err
+	}
+	if v.NewReaction, err = unmarshalSlice(aux.NewReaction, unmarshalReactionType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 
 // This object represents reaction changes on a message with anonymous reactions.
 // 
@@ -3643,11 +4598,40 @@ type UniqueGiftInfo struct {
 //  - OwnedGiftRegular
 //  - OwnedGiftUnique
 // 
-//
-// Use types.CastTo[*type*](*data*)
-//
 // https://core.telegram.org/bots/api#ownedgift
-type OwnedGift map[string] any
+type OwnedGift interface {
+	isOwnedGift()
+}
+
+// unmarshalOwnedGift функция десериализации OwnedGift по значению поля type
+func unmarshalOwnedGift(data []byte) (OwnedGift, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	var d struct {
+		Value json.RawMessage `json:"type"`
+	}
+	if err := json.Unmarshal(data, &d); err != nil {
+		return nil, err
+	}
+
+	var v OwnedGift
+	switch string(d.Value) {
+	case `"regular"`:
+		v = new(OwnedGiftRegular)
+	case `"unique"`:
+		v = new(OwnedGiftUnique)
+	default:
+		return nil, nil
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
 
 
 // Describes a regular gift owned by a user or a chat.
@@ -3696,6 +4680,16 @@ type OwnedGiftRegular struct {
 	
 }
 
+func (OwnedGiftRegular) isOwnedGift() {}
+
+// MarshalJSON метод сериализации OwnedGiftRegular с заполненным полем Type
+func (v OwnedGiftRegular) MarshalJSON() ([]byte, error) {
+	type alias OwnedGiftRegular
+	a := alias(v)
+	a.Type = "regular"
+	return json.Marshal(a)
+}
+
 
 // Describes a unique gift received and owned by a user or a chat.
 // 
@@ -3731,6 +4725,16 @@ type OwnedGiftUnique struct {
 	
 }
 
+func (OwnedGiftUnique) isOwnedGift() {}
+
+// MarshalJSON метод сериализации OwnedGiftUnique с заполненным полем Type
+func (v OwnedGiftUnique) MarshalJSON() ([]byte, error) {
+	type alias OwnedGiftUnique
+	a := alias(v)
+	a.Type = "unique"
+	return json.Marshal(a)
+}
+
 
 // Contains the list of gifts received and owned by a user or a chat.
 // 
@@ -3748,6 +4752,26 @@ type OwnedGifts struct {
 	
 }
 
+// UnmarshalJSON метод десериализации OwnedGifts с полями-объединениями
+func (v *OwnedGifts) UnmarshalJSON(data []byte) error {
+	type alias OwnedGifts
+	aux := struct {
+		*alias
+		Gifts []json.RawMessage `json:"gifts"`
+	}{alias: (*alias)(v)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var err error
+	if v.Gifts, err = unmarshalSlice(aux.Gifts, unmarshalOwnedGift); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 
 // This object describes the types of gifts that can be gifted to a user or a chat.
 // 
@@ -3806,11 +4830,50 @@ type BotCommand struct {
 //  - BotCommandScopeChatAdministrators
 //  - BotCommandScopeChatMember
 // 
-//
-// Use types.CastTo[*type*](*data*)
-//
 // https://core.telegram.org/bots/api#botcommandscope
-type BotCommandScope map[string] any
+type BotCommandScope interface {
+	isBotCommandScope()
+}
+
+// unmarshalBotCommandScope функция десериализации BotCommandScope по значению поля type
+func unmarshalBotCommandScope(data []byte) (BotCommandScope, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	var d struct {
+		Value json.RawMessage `json:"type"`
+	}
+	if err := json.Unmarshal(data, &d); err != nil {
+		return nil, err
+	}
+
+	var v BotCommandScope
+	switch string(d.Value) {
+	case `"default"`:
+		v = new(BotCommandScopeDefault)
+	case `"all_private_chats"`:
+		v = new(BotCommandScopeAllPrivateChats)
+	case `"all_group_chats"`:
+		v = new(BotCommandScopeAllGroupChats)
+	case `"all_chat_administrators"`:
+		v = new(BotCommandScopeAllChatAdministrators)
+	case `"chat"`:
+		v = new(BotCommandScopeChat)
+	case `"chat_administrators"`:
+		v = new(BotCommandScopeChatAdministrators)
+	case `"chat_member"`:
+		v = new(BotCommandScopeChatMember)
+	default:
+		return nil, nil
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
 
 
 // Represents the default scope of bot commands. Default commands are used if no commands with a narrower scope are specified for the user.
@@ -3823,6 +4886,16 @@ type BotCommandScopeDefault struct {
 	
 }
 
+func (BotCommandScopeDefault) isBotCommandScope() {}
+
+// MarshalJSON метод сериализации BotCommandScopeDefault с заполненным полем Type
+func (v BotCommandScopeDefault) MarshalJSON() ([]byte, error) {
+	type alias BotCommandScopeDefault
+	a := alias(v)
+	a.Type = "default"
+	return json.Marshal(a)
+}
+
 
 // Represents the scope of bot commands, covering all private chats.
 // 
@@ -3834,6 +4907,16 @@ type BotCommandScopeAllPrivateChats struct {
 	
 }
 
+func (BotCommandScopeAllPrivateChats) isBotCommandScope() {}
+
+// MarshalJSON метод сериализации BotCommandScopeAllPrivateChats с заполненным полем Type
+func (v BotCommandScopeAllPrivateChats) MarshalJSON() ([]byte, error) {
+	type alias BotCommandScopeAllPrivateChats
+	a := alias(v)
+	a.Type = "all_private_chats"
+	return json.Marshal(a)
+}
+
 
 // Represents the scope of bot commands, covering all group and supergroup chats.
 // 
@@ -3845,6 +4928,16 @@ type BotCommandScopeAllGroupChats struct {
 	
 }
 
+func (BotCommandScopeAllGroupChats) isBotCommandScope() {}
+
+// MarshalJSON метод сериализации BotCommandScopeAllGroupChats с заполненным полем Type
+func (v BotCommandScopeAllGroupChats) MarshalJSON() ([]byte, error) {
+	type alias BotCommandScopeAllGroupChats
+	a := alias(v)
+	a.Type = "all_group_chats"
+	return json.Marshal(a)
+}
+
 
 // Represents the scope of bot commands, covering all group and supergroup chat administrators.
 // 
@@ -3856,6 +4949,16 @@ type BotCommandScopeAllChatAdministrators struct {
 	
 }
 
+func (BotCommandScopeAllChatAdministrators) isBotCommandScope() {}
+
+// MarshalJSON метод сериализации BotCommandScopeAllChatAdministrators с заполненным полем Type
+func (v BotCommandScopeAllChatAdministrators) MarshalJSON() ([]byte, error) {
+	type alias BotCommandScopeAllChatAdministrators
+	a := alias(v)
+	a.Type = "all_chat_administrators"
+	return json.Marshal(a)
+}
+
 
 // Represents the scope of bot commands, covering a specific chat.
 // 
@@ -3866,10 +4969,20 @@ type BotCommandScopeChat struct {
 	Type string `json:"type"`
 	
 	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername). Channel direct messages chats and channel chats aren&#39;t supported.
-	ChatId int64 `json:"chat_id"`
+	ChatId ChatID `json:"chat_id"`
 	
 }
 
+func (BotCommandScopeChat) isBotCommandScope() {}
+
+// MarshalJSON метод сериализации BotCommandScopeChat с заполненным полем Type
+func (v BotCommandScopeChat) MarshalJSON() ([]byte, error) {
+	type alias BotCommandScopeChat
+	a := alias(v)
+	a.Type = "chat"
+	return json.Marshal(a)
+}
+
 
 // Represents the scope of bot commands, covering all administrators of a specific group or supergroup chat.
 // 
@@ -3880,13 +4993,23 @@ type BotCommandScopeChatAdministrators struct {
 	Type string `json:"type"`
 	
 	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername). Channel direct messages chats and channel chats aren&#39;t supported.
-	ChatId int64 `json:"chat_id"`
+	ChatId ChatID `json:"chat_id"`
 	
 }
 
+func (BotCommandScopeChatAdministrators) isBotCommandScope() {}
 
-// Represents the scope of bot commands, covering a specific member of a group or supergroup chat.
-// 
+// MarshalJSON метод сериализации BotCommandScopeChatAdministrators с заполненным полем Type
+func (v BotCommandScopeChatAdministrators) MarshalJSON() ([]byte, error) {
+	type alias BotCommandScopeChatAdministrators
+	a := alias(v)
+	a.Type = "chat_administrators"
+	return json.Marshal(a)
+}
+
+
+// Represents the scope of bot commands, covering a specific member of a group or supergroup chat.
+// 
 // https://core.telegram.org/bots/api#botcommandscopechatmember
 type BotCommandScopeChatMember struct {
 	
@@ -3894,13 +5017,23 @@ type BotCommandScopeChatMember struct {
 	Type string `json:"type"`
 	
 	// Unique identifier for the target chat or username of the target supergroup (in the format @supergroupusername). Channel direct messages chats and channel chats aren&#39;t supported.
-	ChatId int64 `json:"chat_id"`
+	ChatId ChatID `json:"chat_id"`
 	
 	// Unique identifier of the target user
 	UserId int64 `json:"user_id"`
 	
 }
 
+func (BotCommandScopeChatMember) isBotCommandScope() {}
+
+// MarshalJSON метод сериализации BotCommandScopeChatMember с заполненным полем Type
+func (v BotCommandScopeChatMember) MarshalJSON() ([]byte, error) {
+	type alias BotCommandScopeChatMember
+	a := alias(v)
+	a.Type = "chat_member"
+	return json.Marshal(a)
+}
+
 
 // This object represents the bot&#39;s name.
 // 
@@ -3940,11 +5073,42 @@ type BotShortDescription struct {
 //  - MenuButtonWebApp
 //  - MenuButtonDefault
 // 
-//
-// Use types.CastTo[*type*](*data*)
-//
 // https://core.telegram.org/bots/api#menubutton
-type MenuButton map[string] any
+type MenuButton interface {
+	isMenuButton()
+}
+
+// unmarshalMenuButton функция десериализации MenuButton по значению поля type
+func unmarshalMenuButton(data []byte) (MenuButton, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	var d struct {
+		Value json.RawMessage `json:"type"`
+	}
+	if err := json.Unmarshal(data, &d); err != nil {
+		return nil, err
+	}
+
+	var v MenuButton
+	switch string(d.Value) {
+	case `"commands"`:
+		v = new(MenuButtonCommands)
+	case `"web_app"`:
+		v = new(MenuButtonWebApp)
+	case `"default"`:
+		v = new(MenuButtonDefault)
+	default:
+		return nil, nil
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
 
 
 // Represents a menu button, which opens the bot&#39;s list of commands.
@@ -3957,6 +5121,16 @@ type MenuButtonCommands struct {
 	
 }
 
+func (MenuButtonCommands) isMenuButton() {}
+
+// MarshalJSON метод сериализации MenuButtonCommands с заполненным полем Type
+func (v MenuButtonCommands) MarshalJSON() ([]byte, error) {
+	type alias MenuButtonCommands
+	a := alias(v)
+	a.Type = "commands"
+	return json.Marshal(a)
+}
+
 
 // Represents a menu button, which launches a Web App.
 // 
@@ -3974,6 +5148,16 @@ type MenuButtonWebApp struct {
 	
 }
 
+func (MenuButtonWebApp) isMenuButton() {}
+
+// MarshalJSON метод сериализации MenuButtonWebApp с заполненным полем Type
+func (v MenuButtonWebApp) MarshalJSON() ([]byte, error) {
+	type alias MenuButtonWebApp
+	a := alias(v)
+	a.Type = "web_app"
+	return json.Marshal(a)
+}
+
 
 // Describes that no specific value for the menu button was set.
 // 
@@ -3985,17 +5169,58 @@ type MenuButtonDefault struct {
 	
 }
 
+func (MenuButtonDefault) isMenuButton() {}
+
+// MarshalJSON метод сериализации MenuButtonDefault с заполненным полем Type
+func (v MenuButtonDefault) MarshalJSON() ([]byte, error) {
+	type alias MenuButtonDefault
+	a := alias(v)
+	a.Type = "default"
+	return json.Marshal(a)
+}
+
 
 // This object describes the source of a chat boost. It can be one of
 //  - ChatBoostSourcePremium
 //  - ChatBoostSourceGiftCode
 //  - ChatBoostSourceGiveaway
 // 
-//
-// Use types.CastTo[*type*](*data*)
-//
 // https://core.telegram.org/bots/api#chatboostsource
-type ChatBoostSource map[string] any
+type ChatBoostSource interface {
+	isChatBoostSource()
+}
+
+// unmarshalChatBoostSource функция десериализации ChatBoostSource по значению поля source
+func unmarshalChatBoostSource(data []byte) (ChatBoostSource, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	var d struct {
+		Value json.RawMessage `json:"source"`
+	}
+	if err := json.Unmarshal(data, &d); err != nil {
+		return nil, err
+	}
+
+	var v ChatBoostSource
+	switch string(d.Value) {
+	case `"premium"`:
+		v = new(ChatBoostSourcePremium)
+	case `"gift_code"`:
+		v = new(ChatBoostSourceGiftCode)
+	case `"giveaway"`:
+		v = new(ChatBoostSourceGiveaway)
+	default:
+		return nil, nil
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
 
 
 // The boost was obtained by subscribing to Telegram Premium or by gifting a Telegram Premium subscription to another user.
@@ -4011,6 +5236,16 @@ type ChatBoostSourcePremium struct {
 	
 }
 
+func (ChatBoostSourcePremium) isChatBoostSource() {}
+
+// MarshalJSON метод сериализации ChatBoostSourcePremium с заполненным полем Source
+func (v ChatBoostSourcePremium) MarshalJSON() ([]byte, error) {
+	type alias ChatBoostSourcePremium
+	a := alias(v)
+	a.Source = "premium"
+	return json.Marshal(a)
+}
+
 
 // The boost was obtained by the creation of Telegram Premium gift codes to boost a chat. Each such code boosts the chat 4 times for the duration of the corresponding Telegram Premium subscription.
 // 
@@ -4025,6 +5260,16 @@ type ChatBoostSourceGiftCode struct {
 	
 }
 
+func (ChatBoostSourceGiftCode) isChatBoostSource() {}
+
+// MarshalJSON метод сериализации ChatBoostSourceGiftCode с заполненным полем Source
+func (v ChatBoostSourceGiftCode) MarshalJSON() ([]byte, error) {
+	type alias ChatBoostSourceGiftCode
+	a := alias(v)
+	a.Source = "gift_code"
+	return json.Marshal(a)
+}
+
 
 // The boost was obtained by the creation of a Telegram Premium or a Telegram Star giveaway. This boosts the chat 4 times for the duration of the corresponding Telegram Premium subscription for Telegram Premium giveaways and prize_star_count / 500 times for one year for Telegram Star giveaways.
 // 
@@ -4048,6 +5293,16 @@ type ChatBoostSourceGiveaway struct {
 	
 }
 
+func (ChatBoostSourceGiveaway) isChatBoostSource() {}
+
+// MarshalJSON метод сериализации ChatBoostSourceGiveaway с заполненным полем Source
+func (v ChatBoostSourceGiveaway) MarshalJSON() ([]byte, error) {
+	type alias ChatBoostSourceGiveaway
+	a := alias(v)
+	a.Source = "giveaway"
+	return json.Marshal(a)
+}
+
 
 // This object contains information about a chat boost.
 // 
@@ -4064,10 +5319,30 @@ type ChatBoost struct {
 	ExpirationDate int64 `json:"expiration_date"`
 	
 	// Source of the added boost
-	Source *ChatBoostSource `json:"source"`
+	Source ChatBoostSource `json:"source"`
 	
 }
 
+// UnmarshalJSON метод десериализации ChatBoost с полями-объединениями
+func (v *ChatBoost) UnmarshalJSON(data []byte) error {
+	type alias ChatBoost
+	aux := struct {
+		*alias
+		Source json.RawMessage `json:"source"`
+	}{alias: (*alias)(v)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var err error
+	if v.Source, err = unmarshalChatBoostSource(aux.Source); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 
 // This object represents a boost added to a chat or changed.
 // 
@@ -4098,10 +5373,30 @@ type ChatBoostRemoved struct {
 	RemoveDate int64 `json:"remove_date"`
 	
 	// Source of the removed boost
-	Source *ChatBoostSource `json:"source"`
+	Source ChatBoostSource `json:"source"`
 	
 }
 
+// UnmarshalJSON метод десериализации ChatBoostRemoved с полями-объединениями
+func (v *ChatBoostRemoved) UnmarshalJSON(data []byte) error {
+	type alias ChatBoostRemoved
+	aux := struct {
+		*alias
+		Source json.RawMessage `json:"source"`
+	}{alias: (*alias)(v)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var err error
+	if v.Source, err = unmarshalChatBoostSource(aux.Source); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 
 // This object represents a list of boosts added to a chat by a user.
 // 
@@ -4228,11 +5523,46 @@ type ResponseParameters struct {
 //  - InputMediaPhoto
 //  - InputMediaVideo
 // 
-//
-// Use types.CastTo[*type*](*data*)
-//
 // https://core.telegram.org/bots/api#inputmedia
-type InputMedia map[string] any
+type InputMedia interface {
+	isInputMedia()
+}
+
+// unmarshalInputMedia функция десериализации InputMedia по значению поля type
+func unmarshalInputMedia(data []byte) (InputMedia, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	var d struct {
+		Value json.RawMessage `json:"type"`
+	}
+	if err := json.Unmarshal(data, &d); err != nil {
+		return nil, err
+	}
+
+	var v InputMedia
+	switch string(d.Value) {
+	case `"animation"`:
+		v = new(InputMediaAnimation)
+	case `"document"`:
+		v = new(InputMediaDocument)
+	case `"audio"`:
+		v = new(InputMediaAudio)
+	case `"photo"`:
+		v = new(InputMediaPhoto)
+	case `"video"`:
+		v = new(InputMediaVideo)
+	default:
+		return nil, nil
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
 
 
 // Represents a photo to be sent.
@@ -4244,7 +5574,7 @@ type InputMediaPhoto struct {
 	Type string `json:"type"`
 	
 	// File to send. Pass a file_id to send a file that exists on the Telegram servers (recommended), pass an HTTP URL for Telegram to get a file from the Internet, or pass “attach://&lt;file_attach_name&gt;” to upload a new one using multipart/form-data under &lt;file_attach_name&gt; name. More information on Sending Files »
-	Media string `json:"media"`
+	Media *InputFile `json:"media"`
 	
 	// Optional. Caption of the photo to be sent, 0-1024 characters after entities parsing
 	Caption string `json:"caption,omitempty"`
@@ -4263,6 +5593,16 @@ type InputMediaPhoto struct {
 	
 }
 
+func (InputMediaPhoto) isInputMedia() {}
+
+// MarshalJSON метод сериализации InputMediaPhoto с заполненным полем Type
+func (v InputMediaPhoto) MarshalJSON() ([]byte, error) {
+	type alias InputMediaPhoto
+	a := alias(v)
+	a.Type = "photo"
+	return json.Marshal(a)
+}
+
 
 // Represents a video to be sent.
 // 
@@ -4273,13 +5613,13 @@ type InputMediaVideo struct {
 	Type string `json:"type"`
 	
 	// File to send. Pass a file_id to send a file that exists on the Telegram servers (recommended), pass an HTTP URL for Telegram to get a file from the Internet, or pass “attach://&lt;file_attach_name&gt;” to upload a new one using multipart/form-data under &lt;file_attach_name&gt; name. More information on Sending Files »
-	Media string `json:"media"`
+	Media *InputFile `json:"media"`
 	
 	// Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail&#39;s width and height should not exceed 320. Ignored if the file is not uploaded using multipart/form-data. Thumbnails can&#39;t be reused and can be only uploaded as a new file, so you can pass “attach://&lt;file_attach_name&gt;” if the thumbnail was uploaded using multipart/form-data under &lt;file_attach_name&gt;. More information on Sending Files »
-	Thumbnail string `json:"thumbnail,omitempty"`
+	Thumbnail *InputFile `json:"thumbnail,omitempty"`
 	
 	// Optional. Cover for the video in the message. Pass a file_id to send a file that exists on the Telegram servers (recommended), pass an HTTP URL for Telegram to get a file from the Internet, or pass “attach://&lt;file_attach_name&gt;” to upload a new one using multipart/form-data under &lt;file_attach_name&gt; name. More information on Sending Files »
-	Cover string `json:"cover,omitempty"`
+	Cover *InputFile `json:"cover,omitempty"`
 	
 	// Optional. Start timestamp for the video in the message
 	StartTimestamp int64 `json:"start_timestamp,omitempty"`
@@ -4313,6 +5653,16 @@ type InputMediaVideo struct {
 	
 }
 
+func (InputMediaVideo) isInputMedia() {}
+
+// MarshalJSON метод сериализации InputMediaVideo с заполненным полем Type
+func (v InputMediaVideo) MarshalJSON() ([]byte, error) {
+	type alias InputMediaVideo
+	a := alias(v)
+	a.Type = "video"
+	return json.Marshal(a)
+}
+
 
 // Represents an animation file (GIF or H.264/MPEG-4 AVC video without sound) to be sent.
 // 
@@ -4323,10 +5673,10 @@ type InputMediaAnimation struct {
 	Type string `json:"type"`
 	
 	// File to send. Pass a file_id to send a file that exists on the Telegram servers (recommended), pass an HTTP URL for Telegram to get a file from the Internet, or pass “attach://&lt;file_attach_name&gt;” to upload a new one using multipart/form-data under &lt;file_attach_name&gt; name. More information on Sending Files »
-	Media string `json:"media"`
+	Media *InputFile `json:"media"`
 	
 	// Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail&#39;s width and height should not exceed 320. Ignored if the file is not uploaded using multipart/form-data. Thumbnails can&#39;t be reused and can be only uploaded as a new file, so you can pass “attach://&lt;file_attach_name&gt;” if the thumbnail was uploaded using multipart/form-data under &lt;file_attach_name&gt;. More information on Sending Files »
-	Thumbnail string `json:"thumbnail,omitempty"`
+	Thumbnail *InputFile `json:"thumbnail,omitempty"`
 	
 	// Optional. Caption of the animation to be sent, 0-1024 characters after entities parsing
 	Caption string `json:"caption,omitempty"`
@@ -4354,6 +5704,16 @@ type InputMediaAnimation struct {
 	
 }
 
+func (InputMediaAnimation) isInputMedia() {}
+
+// MarshalJSON метод сериализации InputMediaAnimation с заполненным полем Type
+func (v InputMediaAnimation) MarshalJSON() ([]byte, error) {
+	type alias InputMediaAnimation
+	a := alias(v)
+	a.Type = "animation"
+	return json.Marshal(a)
+}
+
 
 // Represents an audio file to be treated as music to be sent.
 // 
@@ -4364,10 +5724,10 @@ type InputMediaAudio struct {
 	Type string `json:"type"`
 	
 	// File to send. Pass a file_id to send a file that exists on the Telegram servers (recommended), pass an HTTP URL for Telegram to get a file from the Internet, or pass “attach://&lt;file_attach_name&gt;” to upload a new one using multipart/form-data under &lt;file_attach_name&gt; name. More information on Sending Files »
-	Media string `json:"media"`
+	Media *InputFile `json:"media"`
 	
 	// Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail&#39;s width and height should not exceed 320. Ignored if the file is not uploaded using multipart/form-data. Thumbnails can&#39;t be reused and can be only uploaded as a new file, so you can pass “attach://&lt;file_attach_name&gt;” if the thumbnail was uploaded using multipart/form-data under &lt;file_attach_name&gt;. More information on Sending Files »
-	Thumbnail string `json:"thumbnail,omitempty"`
+	Thumbnail *InputFile `json:"thumbnail,omitempty"`
 	
 	// Optional. Caption of the audio to be sent, 0-1024 characters after entities parsing
 	Caption string `json:"caption,omitempty"`
@@ -4389,6 +5749,16 @@ type InputMediaAudio struct {
 	
 }
 
+func (InputMediaAudio) isInputMedia() {}
+
+// MarshalJSON метод сериализации InputMediaAudio с заполненным полем Type
+func (v InputMediaAudio) MarshalJSON() ([]byte, error) {
+	type alias InputMediaAudio
+	a := alias(v)
+	a.Type = "audio"
+	return json.Marshal(a)
+}
+
 
 // Represents a general file to be sent.
 // 
@@ -4399,10 +5769,10 @@ type InputMediaDocument struct {
 	Type string `json:"type"`
 	
 	// File to send. Pass a file_id to send a file that exists on the Telegram servers (recommended), pass an HTTP URL for Telegram to get a file from the Internet, or pass “attach://&lt;file_attach_name&gt;” to upload a new one using multipart/form-data under &lt;file_attach_name&gt; name. More information on Sending Files »
-	Media string `json:"media"`
+	Media *InputFile `json:"media"`
 	
 	// Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail&#39;s width and height should not exceed 320. Ignored if the file is not uploaded using multipart/form-data. Thumbnails can&#39;t be reused and can be only uploaded as a new file, so you can pass “attach://&lt;file_attach_name&gt;” if the thumbnail was uploaded using multipart/form-data under &lt;file_attach_name&gt;. More information on Sending Files »
-	Thumbnail string `json:"thumbnail,omitempty"`
+	Thumbnail *InputFile `json:"thumbnail,omitempty"`
 	
 	// Optional. Caption of the document to be sent, 0-1024 characters after entities parsing
 	Caption string `json:"caption,omitempty"`
@@ -4418,12 +5788,14 @@ type InputMediaDocument struct {
 	
 }
 
+func (InputMediaDocument) isInputMedia() {}
 
-// This object represents the contents of a file to be uploaded. Must be posted using multipart/form-data in the usual way that files are uploaded via the browser.
-// 
-// https://core.telegram.org/bots/api#inputfile
-type InputFile struct {
-	  
+// MarshalJSON метод сериализации InputMediaDocument с заполненным полем Type
+func (v InputMediaDocument) MarshalJSON() ([]byte, error) {
+	type alias InputMediaDocument
+	a := alias(v)
+	a.Type = "document"
+	return json.Marshal(a)
 }
 
 
@@ -4431,11 +5803,40 @@ type InputFile struct {
 //  - InputPaidMediaPhoto
 //  - InputPaidMediaVideo
 // 
-//
-// Use types.CastTo[*type*](*data*)
-//
 // https://core.telegram.org/bots/api#inputpaidmedia
-type InputPaidMedia map[string] any
+type InputPaidMedia interface {
+	isInputPaidMedia()
+}
+
+// unmarshalInputPaidMedia функция десериализации InputPaidMedia по значению поля type
+func unmarshalInputPaidMedia(data []byte) (InputPaidMedia, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	var d struct {
+		Value json.RawMessage `json:"type"`
+	}
+	if err := json.Unmarshal(data, &d); err != nil {
+		return nil, err
+	}
+
+	var v InputPaidMedia
+	switch string(d.Value) {
+	case `"photo"`:
+		v = new(InputPaidMediaPhoto)
+	case `"video"`:
+		v = new(InputPaidMediaVideo)
+	default:
+		return nil, nil
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
 
 
 // The paid media to send is a photo.
@@ -4447,10 +5848,20 @@ type InputPaidMediaPhoto struct {
 	Type string `json:"type"`
 	
 	// File to send. Pass a file_id to send a file that exists on the Telegram servers (recommended), pass an HTTP URL for Telegram to get a file from the Internet, or pass “attach://&lt;file_attach_name&gt;” to upload a new one using multipart/form-data under &lt;file_attach_name&gt; name. More information on Sending Files »
-	Media string `json:"media"`
+	Media *InputFile `json:"media"`
 	
 }
 
+func (InputPaidMediaPhoto) isInputPaidMedia() {}
+
+// MarshalJSON метод сериализации InputPaidMediaPhoto с заполненным полем Type
+func (v InputPaidMediaPhoto) MarshalJSON() ([]byte, error) {
+	type alias InputPaidMediaPhoto
+	a := alias(v)
+	a.Type = "photo"
+	return json.Marshal(a)
+}
+
 
 // The paid media to send is a video.
 // 
@@ -4461,13 +5872,13 @@ type InputPaidMediaVideo struct {
 	Type string `json:"type"`
 	
 	// File to send. Pass a file_id to send a file that exists on the Telegram servers (recommended), pass an HTTP URL for Telegram to get a file from the Internet, or pass “attach://&lt;file_attach_name&gt;” to upload a new one using multipart/form-data under &lt;file_attach_name&gt; name. More information on Sending Files »
-	Media string `json:"media"`
+	Media *InputFile `json:"media"`
 	
 	// Optional. Thumbnail of the file sent; can be ignored if thumbnail generation for the file is supported server-side. The thumbnail should be in JPEG format and less than 200 kB in size. A thumbnail&#39;s width and height should not exceed 320. Ignored if the file is not uploaded using multipart/form-data. Thumbnails can&#39;t be reused and can be only uploaded as a new file, so you can pass “attach://&lt;file_attach_name&gt;” if the thumbnail was uploaded using multipart/form-data under &lt;file_attach_name&gt;. More information on Sending Files »
-	Thumbnail string `json:"thumbnail,omitempty"`
+	Thumbnail *InputFile `json:"thumbnail,omitempty"`
 	
 	// Optional. Cover for the video in the message. Pass a file_id to send a file that exists on the Telegram servers (recommended), pass an HTTP URL for Telegram to get a file from the Internet, or pass “attach://&lt;file_attach_name&gt;” to upload a new one using multipart/form-data under &lt;file_attach_name&gt; name. More information on Sending Files »
-	Cover string `json:"cover,omitempty"`
+	Cover *InputFile `json:"cover,omitempty"`
 	
 	// Optional. Start timestamp for the video in the message
 	StartTimestamp int64 `json:"start_timestamp,omitempty"`
@@ -4486,16 +5897,55 @@ type InputPaidMediaVideo struct {
 	
 }
 
+func (InputPaidMediaVideo) isInputPaidMedia() {}
+
+// MarshalJSON метод сериализации InputPaidMediaVideo с заполненным полем Type
+func (v InputPaidMediaVideo) MarshalJSON() ([]byte, error) {
+	type alias InputPaidMediaVideo
+	a := alias(v)
+	a.Type = "video"
+	return json.Marshal(a)
+}
+
 
 // This object describes a profile photo to set. Currently, it can be one of
 //  - InputProfilePhotoStatic
 //  - InputProfilePhotoAnimated
 // 
-//
-// Use types.CastTo[*type*](*data*)
-//
 // https://core.telegram.org/bots/api#inputprofilephoto
-type InputProfilePhoto map[string] any
+type InputProfilePhoto interface {
+	isInputProfilePhoto()
+}
+
+// unmarshalInputProfilePhoto функция десериализации InputProfilePhoto по значению поля type
+func unmarshalInputProfilePhoto(data []byte) (InputProfilePhoto, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	var d struct {
+		Value json.RawMessage `json:"type"`
+	}
+	if err := json.Unmarshal(data, &d); err != nil {
+		return nil, err
+	}
+
+	var v InputProfilePhoto
+	switch string(d.Value) {
+	case `"static"`:
+		v = new(InputProfilePhotoStatic)
+	case `"animated"`:
+		v = new(InputProfilePhotoAnimated)
+	default:
+		return nil, nil
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
 
 
 // A static profile photo in the .JPG format.
@@ -4507,10 +5957,20 @@ type InputProfilePhotoStatic struct {
 	Type string `json:"type"`
 	
 	// The static profile photo. Profile photos can&#39;t be reused and can only be uploaded as a new file, so you can pass “attach://&lt;file_attach_name&gt;” if the photo was uploaded using multipart/form-data under &lt;file_attach_name&gt;. More information on Sending Files »
-	Photo string `json:"photo"`
+	Photo *InputFile `json:"photo"`
 	
 }
 
+func (InputProfilePhotoStatic) isInputProfilePhoto() {}
+
+// MarshalJSON метод сериализации InputProfilePhotoStatic с заполненным полем Type
+func (v InputProfilePhotoStatic) MarshalJSON() ([]byte, error) {
+	type alias InputProfilePhotoStatic
+	a := alias(v)
+	a.Type = "static"
+	return json.Marshal(a)
+}
+
 
 // An animated profile photo in the MPEG4 format.
 // 
@@ -4521,23 +5981,62 @@ type InputProfilePhotoAnimated struct {
 	Type string `json:"type"`
 	
 	// The animated profile photo. Profile photos can&#39;t be reused and can only be uploaded as a new file, so you can pass “attach://&lt;file_attach_name&gt;” if the photo was uploaded using multipart/form-data under &lt;file_attach_name&gt;. More information on Sending Files »
-	Animation string `json:"animation"`
+	Animation *InputFile `json:"animation"`
 	
 	// Optional. Timestamp in seconds of the frame that will be used as the static profile photo. Defaults to 0.0.
 	MainFrameTimestamp float64 `json:"main_frame_timestamp,omitempty"`
 	
 }
 
+func (InputProfilePhotoAnimated) isInputProfilePhoto() {}
+
+// MarshalJSON метод сериализации InputProfilePhotoAnimated с заполненным полем Type
+func (v InputProfilePhotoAnimated) MarshalJSON() ([]byte, error) {
+	type alias InputProfilePhotoAnimated
+	a := alias(v)
+	a.Type = "animated"
+	return json.Marshal(a)
+}
+
 
 // This object describes the content of a story to post. Currently, it can be one of
 //  - InputStoryContentPhoto
 //  - InputStoryContentVideo
 // 
-//
-// Use types.CastTo[*type*](*data*)
-//
 // https://core.telegram.org/bots/api#inputstorycontent
-type InputStoryContent map[string] any
+type InputStoryContent interface {
+	isInputStoryContent()
+}
+
+// unmarshalInputStoryContent функция десериализации InputStoryContent по значению поля type
+func unmarshalInputStoryContent(data []byte) (InputStoryContent, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	var d struct {
+		Value json.RawMessage `json:"type"`
+	}
+	if err := json.Unmarshal(data, &d); err != nil {
+		return nil, err
+	}
+
+	var v InputStoryContent
+	switch string(d.Value) {
+	case `"photo"`:
+		v = new(InputStoryContentPhoto)
+	case `"video"`:
+		v = new(InputStoryContentVideo)
+	default:
+		return nil, nil
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
 
 
 // Describes a photo to post as a story.
@@ -4549,10 +6048,20 @@ type InputStoryContentPhoto struct {
 	Type string `json:"type"`
 	
 	// The photo to post as a story. The photo must be of the size 1080x1920 and must not exceed 10 MB. The photo can&#39;t be reused and can only be uploaded as a new file, so you can pass “attach://&lt;file_attach_name&gt;” if the photo was uploaded using multipart/form-data under &lt;file_attach_name&gt;. More information on Sending Files »
-	Photo string `json:"photo"`
+	Photo *InputFile `json:"photo"`
 	
 }
 
+func (InputStoryContentPhoto) isInputStoryContent() {}
+
+// MarshalJSON метод сериализации InputStoryContentPhoto с заполненным полем Type
+func (v InputStoryContentPhoto) MarshalJSON() ([]byte, error) {
+	type alias InputStoryContentPhoto
+	a := alias(v)
+	a.Type = "photo"
+	return json.Marshal(a)
+}
+
 
 // Describes a video to post as a story.
 // 
@@ -4563,7 +6072,7 @@ type InputStoryContentVideo struct {
 	Type string `json:"type"`
 	
 	// The video to post as a story. The video must be of the size 720x1280, streamable, encoded with H.265 codec, with key frames added each second in the MPEG4 format, and must not exceed 30 MB. The video can&#39;t be reused and can only be uploaded as a new file, so you can pass “attach://&lt;file_attach_name&gt;” if the video was uploaded using multipart/form-data under &lt;file_attach_name&gt;. More information on Sending Files »
-	Video string `json:"video"`
+	Video *InputFile `json:"video"`
 	
 	// Optional. Precise duration of the video in seconds; 0-60
 	Duration float64 `json:"duration,omitempty"`
@@ -4576,6 +6085,16 @@ type InputStoryContentVideo struct {
 	
 }
 
+func (InputStoryContentVideo) isInputStoryContent() {}
+
+// MarshalJSON метод сериализации InputStoryContentVideo с заполненным полем Type
+func (v InputStoryContentVideo) MarshalJSON() ([]byte, error) {
+	type alias InputStoryContentVideo
+	a := alias(v)
+	a.Type = "video"
+	return json.Marshal(a)
+}
+
 
 // This object represents a sticker.
 // 
@@ -4679,7 +6198,7 @@ type MaskPosition struct {
 type InputSticker struct {
 	
 	// The added sticker. Pass a file_id as a String to send a file that already exists on the Telegram servers, pass an HTTP URL as a String for Telegram to get a file from the Internet, or pass “attach://&lt;file_attach_name&gt;” to upload a new file using multipart/form-data under &lt;file_attach_name&gt; name. Animated and video stickers can&#39;t be uploaded via HTTP URL. More information on Sending Files »
-	Sticker string `json:"sticker"`
+	Sticker *InputFile `json:"sticker"`
 	
 	// Format of the added sticker, must be one of “static” for a .WEBP or .PNG image, “animated” for a .TGS animation, “video” for a .WEBM video
 	Format string `json:"format"`
@@ -4761,11 +6280,10 @@ type InlineQueryResultsButton struct {
 //  - InlineQueryResultVideo
 //  - InlineQueryResultVoice
 // 
-//
-// Use types.CastTo[*type*](*data*)
-//
 // https://core.telegram.org/bots/api#inlinequeryresult
-type InlineQueryResult map[string] any
+type InlineQueryResult interface {
+	isInlineQueryResult()
+}
 
 
 // Represents a link to an article or web page.
@@ -4783,7 +6301,7 @@ type InlineQueryResultArticle struct {
 	Title string `json:"title"`
 	
 	// Content of the message to be sent
-	InputMessageContent *InputMessageContent `json:"input_message_content"`
+	InputMessageContent InputMessageContent `json:"input_message_content"`
 	
 	// Optional. Inline keyboard attached to the message
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
@@ -4805,6 +6323,16 @@ type InlineQueryResultArticle struct {
 	
 }
 
+func (InlineQueryResultArticle) isInlineQueryResult() {}
+
+// MarshalJSON метод сериализации InlineQueryResultArticle с заполненным полем Type
+func (v InlineQueryResultArticle) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultArticle
+	a := alias(v)
+	a.Type = "article"
+	return json.Marshal(a)
+}
+
 
 // Represents a link to a photo. By default, this photo will be sent by the user with optional caption. Alternatively, you can use input_message_content to send a message with the specified content instead of the photo.
 // 
@@ -4851,10 +6379,20 @@ type InlineQueryResultPhoto struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 	
 	// Optional. Content of the message to be sent instead of the photo
-	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
 	
 }
 
+func (InlineQueryResultPhoto) isInlineQueryResult() {}
+
+// MarshalJSON метод сериализации InlineQueryResultPhoto с заполненным полем Type
+func (v InlineQueryResultPhoto) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultPhoto
+	a := alias(v)
+	a.Type = "photo"
+	return json.Marshal(a)
+}
+
 
 // Represents a link to an animated GIF file. By default, this animated GIF file will be sent by the user with optional caption. Alternatively, you can use input_message_content to send a message with the specified content instead of the animation.
 // 
@@ -4904,10 +6442,20 @@ type InlineQueryResultGif struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 	
 	// Optional. Content of the message to be sent instead of the GIF animation
-	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
 	
 }
 
+func (InlineQueryResultGif) isInlineQueryResult() {}
+
+// MarshalJSON метод сериализации InlineQueryResultGif с заполненным полем Type
+func (v InlineQueryResultGif) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultGif
+	a := alias(v)
+	a.Type = "gif"
+	return json.Marshal(a)
+}
+
 
 // Represents a link to a video animation (H.264/MPEG-4 AVC video without sound). By default, this animated MPEG-4 file will be sent by the user with optional caption. Alternatively, you can use input_message_content to send a message with the specified content instead of the animation.
 // 
@@ -4957,10 +6505,20 @@ type InlineQueryResultMpeg4Gif struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 	
 	// Optional. Content of the message to be sent instead of the video animation
-	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
 	
 }
 
+func (InlineQueryResultMpeg4Gif) isInlineQueryResult() {}
+
+// MarshalJSON метод сериализации InlineQueryResultMpeg4Gif с заполненным полем Type
+func (v InlineQueryResultMpeg4Gif) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultMpeg4Gif
+	a := alias(v)
+	a.Type = "mpeg4_gif"
+	return json.Marshal(a)
+}
+
 
 // Represents a link to a page containing an embedded video player or a video file. By default, this video file will be sent by the user with an optional caption. Alternatively, you can use input_message_content to send a message with the specified content instead of the video.
 //
@@ -5015,10 +6573,20 @@ type InlineQueryResultVideo struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 	
 	// Optional. Content of the message to be sent instead of the video. This field is required if InlineQueryResultVideo is used to send an HTML-page as a result (e.g., a YouTube video).
-	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
 	
 }
 
+func (InlineQueryResultVideo) isInlineQueryResult() {}
+
+// MarshalJSON метод сериализации InlineQueryResultVideo с заполненным полем Type
+func (v InlineQueryResultVideo) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultVideo
+	a := alias(v)
+	a.Type = "video"
+	return json.Marshal(a)
+}
+
 
 // Represents a link to an MP3 audio file. By default, this audio file will be sent by the user. Alternatively, you can use input_message_content to send a message with the specified content instead of the audio.
 // 
@@ -5056,10 +6624,20 @@ type InlineQueryResultAudio struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 	
 	// Optional. Content of the message to be sent instead of the audio
-	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
 	
 }
 
+func (InlineQueryResultAudio) isInlineQueryResult() {}
+
+// MarshalJSON метод сериализации InlineQueryResultAudio с заполненным полем Type
+func (v InlineQueryResultAudio) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultAudio
+	a := alias(v)
+	a.Type = "audio"
+	return json.Marshal(a)
+}
+
 
 // Represents a link to a voice recording in an .OGG container encoded with OPUS. By default, this voice recording will be sent by the user. Alternatively, you can use input_message_content to send a message with the specified content instead of the the voice message.
 // 
@@ -5094,10 +6672,20 @@ type InlineQueryResultVoice struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 	
 	// Optional. Content of the message to be sent instead of the voice recording
-	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
 	
 }
 
+func (InlineQueryResultVoice) isInlineQueryResult() {}
+
+// MarshalJSON метод сериализации InlineQueryResultVoice с заполненным полем Type
+func (v InlineQueryResultVoice) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultVoice
+	a := alias(v)
+	a.Type = "voice"
+	return json.Marshal(a)
+}
+
 
 // Represents a link to a file. By default, this file will be sent by the user with an optional caption. Alternatively, you can use input_message_content to send a message with the specified content instead of the file. Currently, only .PDF and .ZIP files can be sent using this method.
 // 
@@ -5135,7 +6723,7 @@ type InlineQueryResultDocument struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 	
 	// Optional. Content of the message to be sent instead of the file
-	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
 	
 	// Optional. URL of the thumbnail (JPEG only) for the file
 	ThumbnailUrl string `json:"thumbnail_url,omitempty"`
@@ -5148,6 +6736,16 @@ type InlineQueryResultDocument struct {
 	
 }
 
+func (InlineQueryResultDocument) isInlineQueryResult() {}
+
+// MarshalJSON метод сериализации InlineQueryResultDocument с заполненным полем Type
+func (v InlineQueryResultDocument) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultDocument
+	a := alias(v)
+	a.Type = "document"
+	return json.Marshal(a)
+}
+
 
 // Represents a location on a map. By default, the location will be sent by the user. Alternatively, you can use input_message_content to send a message with the specified content instead of the location.
 // 
@@ -5185,7 +6783,7 @@ type InlineQueryResultLocation struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 	
 	// Optional. Content of the message to be sent instead of the location
-	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
 	
 	// Optional. Url of the thumbnail for the result
 	ThumbnailUrl string `json:"thumbnail_url,omitempty"`
@@ -5198,6 +6796,16 @@ type InlineQueryResultLocation struct {
 	
 }
 
+func (InlineQueryResultLocation) isInlineQueryResult() {}
+
+// MarshalJSON метод сериализации InlineQueryResultLocation с заполненным полем Type
+func (v InlineQueryResultLocation) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultLocation
+	a := alias(v)
+	a.Type = "location"
+	return json.Marshal(a)
+}
+
 
 // Represents a venue. By default, the venue will be sent by the user. Alternatively, you can use input_message_content to send a message with the specified content instead of the venue.
 // 
@@ -5238,7 +6846,7 @@ type InlineQueryResultVenue struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 	
 	// Optional. Content of the message to be sent instead of the venue
-	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
 	
 	// Optional. Url of the thumbnail for the result
 	ThumbnailUrl string `json:"thumbnail_url,omitempty"`
@@ -5251,6 +6859,16 @@ type InlineQueryResultVenue struct {
 	
 }
 
+func (InlineQueryResultVenue) isInlineQueryResult() {}
+
+// MarshalJSON метод сериализации InlineQueryResultVenue с заполненным полем Type
+func (v InlineQueryResultVenue) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultVenue
+	a := alias(v)
+	a.Type = "venue"
+	return json.Marshal(a)
+}
+
 
 // Represents a contact with a phone number. By default, this contact will be sent by the user. Alternatively, you can use input_message_content to send a message with the specified content instead of the contact.
 // 
@@ -5279,7 +6897,7 @@ type InlineQueryResultContact struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 	
 	// Optional. Content of the message to be sent instead of the contact
-	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
 	
 	// Optional. Url of the thumbnail for the result
 	ThumbnailUrl string `json:"thumbnail_url,omitempty"`
@@ -5292,6 +6910,16 @@ type InlineQueryResultContact struct {
 	
 }
 
+func (InlineQueryResultContact) isInlineQueryResult() {}
+
+// MarshalJSON метод сериализации InlineQueryResultContact с заполненным полем Type
+func (v InlineQueryResultContact) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultContact
+	a := alias(v)
+	a.Type = "contact"
+	return json.Marshal(a)
+}
+
 
 // Represents a Game.
 // 
@@ -5312,6 +6940,16 @@ type InlineQueryResultGame struct {
 	
 }
 
+func (InlineQueryResultGame) isInlineQueryResult() {}
+
+// MarshalJSON метод сериализации InlineQueryResultGame с заполненным полем Type
+func (v InlineQueryResultGame) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultGame
+	a := alias(v)
+	a.Type = "game"
+	return json.Marshal(a)
+}
+
 
 // Represents a link to a photo stored on the Telegram servers. By default, this photo will be sent by the user with an optional caption. Alternatively, you can use input_message_content to send a message with the specified content instead of the photo.
 // 
@@ -5349,10 +6987,20 @@ type InlineQueryResultCachedPhoto struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 	
 	// Optional. Content of the message to be sent instead of the photo
-	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
 	
 }
 
+func (InlineQueryResultCachedPhoto) isInlineQueryResult() {}
+
+// MarshalJSON метод сериализации InlineQueryResultCachedPhoto с заполненным полем Type
+func (v InlineQueryResultCachedPhoto) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultCachedPhoto
+	a := alias(v)
+	a.Type = "photo"
+	return json.Marshal(a)
+}
+
 
 // Represents a link to an animated GIF file stored on the Telegram servers. By default, this animated GIF file will be sent by the user with an optional caption. Alternatively, you can use input_message_content to send a message with specified content instead of the animation.
 // 
@@ -5387,10 +7035,20 @@ type InlineQueryResultCachedGif struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 	
 	// Optional. Content of the message to be sent instead of the GIF animation
-	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
 	
 }
 
+func (InlineQueryResultCachedGif) isInlineQueryResult() {}
+
+// MarshalJSON метод сериализации InlineQueryResultCachedGif с заполненным полем Type
+func (v InlineQueryResultCachedGif) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultCachedGif
+	a := alias(v)
+	a.Type = "gif"
+	return json.Marshal(a)
+}
+
 
 // Represents a link to a video animation (H.264/MPEG-4 AVC video without sound) stored on the Telegram servers. By default, this animated MPEG-4 file will be sent by the user with an optional caption. Alternatively, you can use input_message_content to send a message with the specified content instead of the animation.
 // 
@@ -5425,10 +7083,20 @@ type InlineQueryResultCachedMpeg4Gif struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 	
 	// Optional. Content of the message to be sent instead of the video animation
-	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
 	
 }
 
+func (InlineQueryResultCachedMpeg4Gif) isInlineQueryResult() {}
+
+// MarshalJSON метод сериализации InlineQueryResultCachedMpeg4Gif с заполненным полем Type
+func (v InlineQueryResultCachedMpeg4Gif) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultCachedMpeg4Gif
+	a := alias(v)
+	a.Type = "mpeg4_gif"
+	return json.Marshal(a)
+}
+
 
 // Represents a link to a sticker stored on the Telegram servers. By default, this sticker will be sent by the user. Alternatively, you can use input_message_content to send a message with the specified content instead of the sticker.
 // 
@@ -5448,10 +7116,20 @@ type InlineQueryResultCachedSticker struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 	
 	// Optional. Content of the message to be sent instead of the sticker
-	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
 	
 }
 
+func (InlineQueryResultCachedSticker) isInlineQueryResult() {}
+
+// MarshalJSON метод сериализации InlineQueryResultCachedSticker с заполненным полем Type
+func (v InlineQueryResultCachedSticker) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultCachedSticker
+	a := alias(v)
+	a.Type = "sticker"
+	return json.Marshal(a)
+}
+
 
 // Represents a link to a file stored on the Telegram servers. By default, this file will be sent by the user with an optional caption. Alternatively, you can use input_message_content to send a message with the specified content instead of the file.
 // 
@@ -5486,10 +7164,20 @@ type InlineQueryResultCachedDocument struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 	
 	// Optional. Content of the message to be sent instead of the file
-	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
 	
 }
 
+func (InlineQueryResultCachedDocument) isInlineQueryResult() {}
+
+// MarshalJSON метод сериализации InlineQueryResultCachedDocument с заполненным полем Type
+func (v InlineQueryResultCachedDocument) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultCachedDocument
+	a := alias(v)
+	a.Type = "document"
+	return json.Marshal(a)
+}
+
 
 // Represents a link to a video file stored on the Telegram servers. By default, this video file will be sent by the user with an optional caption. Alternatively, you can use input_message_content to send a message with the specified content instead of the video.
 // 
@@ -5527,10 +7215,20 @@ type InlineQueryResultCachedVideo struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 	
 	// Optional. Content of the message to be sent instead of the video
-	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
 	
 }
 
+func (InlineQueryResultCachedVideo) isInlineQueryResult() {}
+
+// MarshalJSON метод сериализации InlineQueryResultCachedVideo с заполненным полем Type
+func (v InlineQueryResultCachedVideo) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultCachedVideo
+	a := alias(v)
+	a.Type = "video"
+	return json.Marshal(a)
+}
+
 
 // Represents a link to a voice message stored on the Telegram servers. By default, this voice message will be sent by the user. Alternatively, you can use input_message_content to send a message with the specified content instead of the voice message.
 // 
@@ -5562,10 +7260,20 @@ type InlineQueryResultCachedVoice struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 	
 	// Optional. Content of the message to be sent instead of the voice message
-	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
 	
 }
 
+func (InlineQueryResultCachedVoice) isInlineQueryResult() {}
+
+// MarshalJSON метод сериализации InlineQueryResultCachedVoice с заполненным полем Type
+func (v InlineQueryResultCachedVoice) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultCachedVoice
+	a := alias(v)
+	a.Type = "voice"
+	return json.Marshal(a)
+}
+
 
 // Represents a link to an MP3 audio file stored on the Telegram servers. By default, this audio file will be sent by the user. Alternatively, you can use input_message_content to send a message with the specified content instead of the audio.
 // 
@@ -5594,10 +7302,20 @@ type InlineQueryResultCachedAudio struct {
 	ReplyMarkup *InlineKeyboardMarkup `json:"reply_markup,omitempty"`
 	
 	// Optional. Content of the message to be sent instead of the audio
-	InputMessageContent *InputMessageContent `json:"input_message_content,omitempty"`
+	InputMessageContent InputMessageContent `json:"input_message_content,omitempty"`
 	
 }
 
+func (InlineQueryResultCachedAudio) isInlineQueryResult() {}
+
+// MarshalJSON метод сериализации InlineQueryResultCachedAudio с заполненным полем Type
+func (v InlineQueryResultCachedAudio) MarshalJSON() ([]byte, error) {
+	type alias InlineQueryResultCachedAudio
+	a := alias(v)
+	a.Type = "audio"
+	return json.Marshal(a)
+}
+
 
 // This object represents the content of a message to be sent as a result of an inline query. Telegram clients currently support the following 5 types:
 //  - InputTextMessageContent
@@ -5606,11 +7324,10 @@ type InlineQueryResultCachedAudio struct {
 //  - InputContactMessageContent
 //  - InputInvoiceMessageContent
 // 
-//
-// Use types.CastTo[*type*](*data*)
-//
 // https://core.telegram.org/bots/api#inputmessagecontent
-type InputMessageContent map[string] any
+type InputMessageContent interface {
+	isInputMessageContent()
+}
 
 
 // Represents the content of a text message to be sent as the result of an inline query.
@@ -5632,6 +7349,8 @@ type InputTextMessageContent struct {
 	
 }
 
+func (InputTextMessageContent) isInputMessageContent() {}
+
 
 // Represents the content of a location message to be sent as the result of an inline query.
 // 
@@ -5658,6 +7377,8 @@ type InputLocationMessageContent struct {
 	
 }
 
+func (InputLocationMessageContent) isInputMessageContent() {}
+
 
 // Represents the content of a venue message to be sent as the result of an inline query.
 // 
@@ -5690,6 +7411,8 @@ type InputVenueMessageContent struct {
 	
 }
 
+func (InputVenueMessageContent) isInputMessageContent() {}
+
 
 // Represents the content of a contact message to be sent as the result of an inline query.
 // 
@@ -5710,6 +7433,8 @@ type InputContactMessageContent struct {
 	
 }
 
+func (InputContactMessageContent) isInputMessageContent() {}
+
 
 // Represents the content of an invoice message to be sent as the result of an inline query.
 // 
@@ -5778,6 +7503,8 @@ type InputInvoiceMessageContent struct {
 	
 }
 
+func (InputInvoiceMessageContent) isInputMessageContent() {}
+
 
 // Represents a result of an inline query that was chosen by the user and sent to their chat partner.
 // 
@@ -6056,11 +7783,42 @@ type PaidMediaPurchased struct {
 //  - RevenueWithdrawalStateSucceeded
 //  - RevenueWithdrawalStateFailed
 // 
-//
-// Use types.CastTo[*type*](*data*)
-//
 // https://core.telegram.org/bots/api#revenuewithdrawalstate
-type RevenueWithdrawalState map[string] any
+type RevenueWithdrawalState interface {
+	isRevenueWithdrawalState()
+}
+
+// unmarshalRevenueWithdrawalState функция десериализации RevenueWithdrawalState по значению поля type
+func unmarshalRevenueWithdrawalState(data []byte) (RevenueWithdrawalState, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	var d struct {
+		Value json.RawMessage `json:"type"`
+	}
+	if err := json.Unmarshal(data, &d); err != nil {
+		return nil, err
+	}
+
+	var v RevenueWithdrawalState
+	switch string(d.Value) {
+	case `"pending"`:
+		v = new(RevenueWithdrawalStatePending)
+	case `"succeeded"`:
+		v = new(RevenueWithdrawalStateSucceeded)
+	case `"failed"`:
+		v = new(RevenueWithdrawalStateFailed)
+	default:
+		return nil, nil
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
 
 
 // The withdrawal is in progress.
@@ -6073,6 +7831,16 @@ type RevenueWithdrawalStatePending struct {
 	
 }
 
+func (RevenueWithdrawalStatePending) isRevenueWithdrawalState() {}
+
+// MarshalJSON метод сериализации RevenueWithdrawalStatePending с заполненным полем Type
+func (v RevenueWithdrawalStatePending) MarshalJSON() ([]byte, error) {
+	type alias RevenueWithdrawalStatePending
+	a := alias(v)
+	a.Type = "pending"
+	return json.Marshal(a)
+}
+
 
 // The withdrawal succeeded.
 // 
@@ -6090,6 +7858,16 @@ type RevenueWithdrawalStateSucceeded struct {
 	
 }
 
+func (RevenueWithdrawalStateSucceeded) isRevenueWithdrawalState() {}
+
+// MarshalJSON метод сериализации RevenueWithdrawalStateSucceeded с заполненным полем Type
+func (v RevenueWithdrawalStateSucceeded) MarshalJSON() ([]byte, error) {
+	type alias RevenueWithdrawalStateSucceeded
+	a := alias(v)
+	a.Type = "succeeded"
+	return json.Marshal(a)
+}
+
 
 // The withdrawal failed and the transaction was refunded.
 // 
@@ -6101,6 +7879,16 @@ type RevenueWithdrawalStateFailed struct {
 	
 }
 
+func (RevenueWithdrawalStateFailed) isRevenueWithdrawalState() {}
+
+// MarshalJSON метод сериализации RevenueWithdrawalStateFailed с заполненным полем Type
+func (v RevenueWithdrawalStateFailed) MarshalJSON() ([]byte, error) {
+	type alias RevenueWithdrawalStateFailed
+	a := alias(v)
+	a.Type = "failed"
+	return json.Marshal(a)
+}
+
 
 // Contains information about the affiliate that received a commission via this transaction.
 // 
@@ -6134,11 +7922,50 @@ type AffiliateInfo struct {
 //  - TransactionPartnerTelegramApi
 //  - TransactionPartnerOther
 // 
-//
-// Use types.CastTo[*type*](*data*)
-//
 // https://core.telegram.org/bots/api#transactionpartner
-type TransactionPartner map[string] any
+type TransactionPartner interface {
+	isTransactionPartner()
+}
+
+// unmarshalTransactionPartner функция десериализации TransactionPartner по значению поля type
+func unmarshalTransactionPartner(data []byte) (TransactionPartner, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	var d struct {
+		Value json.RawMessage `json:"type"`
+	}
+	if err := json.Unmarshal(data, &d); err != nil {
+		return nil, err
+	}
+
+	var v TransactionPartner
+	switch string(d.Value) {
+	case `"user"`:
+		v = new(TransactionPartnerUser)
+	case `"chat"`:
+		v = new(TransactionPartnerChat)
+	case `"affiliate_program"`:
+		v = new(TransactionPartnerAffiliateProgram)
+	case `"fragment"`:
+		v = new(TransactionPartnerFragment)
+	case `"telegram_ads"`:
+		v = new(TransactionPartnerTelegramAds)
+	case `"telegram_api"`:
+		v = new(TransactionPartnerTelegramApi)
+	case `"other"`:
+		v = new(TransactionPartnerOther)
+	default:
+		return nil, nil
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
 
 
 // Describes a transaction with a user.
@@ -6178,6 +8005,36 @@ type TransactionPartnerUser struct {
 	
 }
 
+func (TransactionPartnerUser) isTransactionPartner() {}
+
+// MarshalJSON метод сериализации TransactionPartnerUser с заполненным полем Type
+func (v TransactionPartnerUser) MarshalJSON() ([]byte, error) {
+	type alias TransactionPartnerUser
+	a := alias(v)
+	a.Type = "user"
+	return json.Marshal(a)
+}
+
+// UnmarshalJSON метод десериализации TransactionPartnerUser с полями-объединениями
+func (v *TransactionPartnerUser) UnmarshalJSON(data []byte) error {
+	type alias TransactionPartnerUser
+	aux := struct {
+		*alias
+		PaidMedia []json.RawMessage `json:"paid_media"`
+	}{alias: (*alias)(v)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var err error
+	if v.PaidMedia, err = unmarshalSlice(aux.PaidMedia, unmarshalPaidMedia); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 
 // Describes a transaction with a chat.
 // 
@@ -6195,6 +8052,16 @@ type TransactionPartnerChat struct {
 	
 }
 
+func (TransactionPartnerChat) isTransactionPartner() {}
+
+// MarshalJSON метод сериализации TransactionPartnerChat с заполненным полем Type
+func (v TransactionPartnerChat) MarshalJSON() ([]byte, error) {
+	type alias TransactionPartnerChat
+	a := alias(v)
+	a.Type = "chat"
+	return json.Marshal(a)
+}
+
 
 // Describes the affiliate program that issued the affiliate commission received via this transaction.
 // 
@@ -6212,6 +8079,16 @@ type TransactionPartnerAffiliateProgram struct {
 	
 }
 
+func (TransactionPartnerAffiliateProgram) isTransactionPartner() {}
+
+// MarshalJSON метод сериализации TransactionPartnerAffiliateProgram с заполненным полем Type
+func (v TransactionPartnerAffiliateProgram) MarshalJSON() ([]byte, error) {
+	type alias TransactionPartnerAffiliateProgram
+	a := alias(v)
+	a.Type = "affiliate_program"
+	return json.Marshal(a)
+}
+
 
 // Describes a withdrawal transaction with Fragment.
 // 
@@ -6222,10 +8099,40 @@ type TransactionPartnerFragment struct {
 	Type string `json:"type"`
 	
 	// Optional. State of the transaction if the transaction is outgoing
-	WithdrawalState *RevenueWithdrawalState `json:"withdrawal_state,omitempty"`
+	WithdrawalState RevenueWithdrawalState `json:"withdrawal_state,omitempty"`
 	
 }
 
+func (TransactionPartnerFragment) isTransactionPartner() {}
+
+// MarshalJSON метод сериализации TransactionPartnerFragment с заполненным полем Type
+func (v TransactionPartnerFragment) MarshalJSON() ([]byte, error) {
+	type alias TransactionPartnerFragment
+	a := alias(v)
+	a.Type = "fragment"
+	return json.Marshal(a)
+}
+
+// UnmarshalJSON метод десериализации TransactionPartnerFragment с полями-объединениями
+func (v *TransactionPartnerFragment) UnmarshalJSON(data []byte) error {
+	type alias TransactionPartnerFragment
+	aux := struct {
+		*alias
+		WithdrawalState json.RawMessage `json:"withdrawal_state"`
+	}{alias: (*alias)(v)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var err error
+	if v.WithdrawalState, err = unmarshalRevenueWithdrawalState(aux.WithdrawalState); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 
 // Describes a withdrawal transaction to the Telegram Ads platform.
 // 
@@ -6237,6 +8144,16 @@ type TransactionPartnerTelegramAds struct {
 	
 }
 
+func (TransactionPartnerTelegramAds) isTransactionPartner() {}
+
+// MarshalJSON метод сериализации TransactionPartnerTelegramAds с заполненным полем Type
+func (v TransactionPartnerTelegramAds) MarshalJSON() ([]byte, error) {
+	type alias TransactionPartnerTelegramAds
+	a := alias(v)
+	a.Type = "telegram_ads"
+	return json.Marshal(a)
+}
+
 
 // Describes a transaction with payment for paid broadcasting.
 // 
@@ -6251,6 +8168,16 @@ type TransactionPartnerTelegramApi struct {
 	
 }
 
+func (TransactionPartnerTelegramApi) isTransactionPartner() {}
+
+// MarshalJSON метод сериализации TransactionPartnerTelegramApi с заполненным полем Type
+func (v TransactionPartnerTelegramApi) MarshalJSON() ([]byte, error) {
+	type alias TransactionPartnerTelegramApi
+	a := alias(v)
+	a.Type = "telegram_api"
+	return json.Marshal(a)
+}
+
 
 // Describes a transaction with an unknown source or recipient.
 // 
@@ -6262,6 +8189,16 @@ type TransactionPartnerOther struct {
 	
 }
 
+func (TransactionPartnerOther) isTransactionPartner() {}
+
+// MarshalJSON метод сериализации TransactionPartnerOther с заполненным полем Type
+func (v TransactionPartnerOther) MarshalJSON() ([]byte, error) {
+	type alias TransactionPartnerOther
+	a := alias(v)
+	a.Type = "other"
+	return json.Marshal(a)
+}
+
 
 // Describes a Telegram Star transaction. Note that if the buyer initiates a chargeback with the payment provider from whom they acquired Stars (e.g., Apple, Google) following this transaction, the refunded Stars will be deducted from the bot&#39;s balance. This is outside of Telegram&#39;s control.
 // 
@@ -6281,13 +8218,37 @@ type StarTransaction struct {
 	Date int64 `json:"date"`
 	
 	// Optional. Source of an incoming transaction (e.g., a user purchasing goods or services, Fragment refunding a failed withdrawal). Only for incoming transactions
-	Source *TransactionPartner `json:"source,omitempty"`
+	Source TransactionPartner `json:"source,omitempty"`
 	
 	// Optional. Receiver of an outgoing transaction (e.g., a user for a purchase refund, Fragment for a withdrawal). Only for outgoing transactions
-	Receiver *TransactionPartner `json:"receiver,omitempty"`
+	Receiver TransactionPartner `json:"receiver,omitempty"`
 	
 }
 
+// UnmarshalJSON метод десериализации StarTransaction с полями-объединениями
+func (v *StarTransaction) UnmarshalJSON(data []byte) error {
+	type alias StarTransaction
+	aux := struct {
+		*alias
+		Source json.RawMessage `json:"source"`
+		Receiver json.RawMessage `json:"receiver"`
+	}{alias: (*alias)(v)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	var err error
+	if v.Source, err = unmarshalTransactionPartner(aux.Source); err != nil {
+		return err
+	}
+	if v.Receiver, err = unmarshalTransactionPartner(aux.Receiver); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 
 // Contains a list of Telegram Star transactions.
 // 
@@ -6400,11 +8361,54 @@ type EncryptedCredentials struct {
 //  - PassportElementErrorTranslationFiles
 //  - PassportElementErrorUnspecified
 // 
-//
-// Use types.CastTo[*type*](*data*)
-//
 // https://core.telegram.org/bots/api#passportelementerror
-type PassportElementError map[string] any
+type PassportElementError interface {
+	isPassportElementError()
+}
+
+// unmarshalPassportElementError функция десериализации PassportElementError по значению поля source
+func unmarshalPassportElementError(data []byte) (PassportElementError, error) {
+	if isNull(data) {
+		return nil, nil
+	}
+
+	var d struct {
+		Value json.RawMessage `json:"source"`
+	}
+	if err := json.Unmarshal(data, &d); err != nil {
+		return nil, err
+	}
+
+	var v PassportElementError
+	switch string(d.Value) {
+	case `"data"`:
+		v = new(PassportElementErrorDataField)
+	case `"front_side"`:
+		v = new(PassportElementErrorFrontSide)
+	case `"reverse_side"`:
+		v = new(PassportElementErrorReverseSide)
+	case `"selfie"`:
+		v = new(PassportElementErrorSelfie)
+	case `"file"`:
+		v = new(PassportElementErrorFile)
+	case `"files"`:
+		v = new(PassportElementErrorFiles)
+	case `"translation_file"`:
+		v = new(PassportElementErrorTranslationFile)
+	case `"translation_files"`:
+		v = new(PassportElementErrorTranslationFiles)
+	case `"unspecified"`:
+		v = new(PassportElementErrorUnspecified)
+	default:
+		return nil, nil
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
 
 
 // Represents an issue in one of the data fields that was provided by the user. The error is considered resolved when the field&#39;s value changes.
@@ -6429,6 +8433,16 @@ type PassportElementErrorDataField struct {
 	
 }
 
+func (PassportElementErrorDataField) isPassportElementError() {}
+
+// MarshalJSON метод сериализации PassportElementErrorDataField с заполненным полем Source
+func (v PassportElementErrorDataField) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorDataField
+	a := alias(v)
+	a.Source = "data"
+	return json.Marshal(a)
+}
+
 
 // Represents an issue with the front side of a document. The error is considered resolved when the file with the front side of the document changes.
 // 
@@ -6449,6 +8463,16 @@ type PassportElementErrorFrontSide struct {
 	
 }
 
+func (PassportElementErrorFrontSide) isPassportElementError() {}
+
+// MarshalJSON метод сериализации PassportElementErrorFrontSide с заполненным полем Source
+func (v PassportElementErrorFrontSide) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorFrontSide
+	a := alias(v)
+	a.Source = "front_side"
+	return json.Marshal(a)
+}
+
 
 // Represents an issue with the reverse side of a document. The error is considered resolved when the file with reverse side of the document changes.
 // 
@@ -6469,6 +8493,16 @@ type PassportElementErrorReverseSide struct {
 	
 }
 
+func (PassportElementErrorReverseSide) isPassportElementError() {}
+
+// MarshalJSON метод сериализации PassportElementErrorReverseSide с заполненным полем Source
+func (v PassportElementErrorReverseSide) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorReverseSide
+	a := alias(v)
+	a.Source = "reverse_side"
+	return json.Marshal(a)
+}
+
 
 // Represents an issue with the selfie with a document. The error is considered resolved when the file with the selfie changes.
 // 
@@ -6489,6 +8523,16 @@ type PassportElementErrorSelfie struct {
 	
 }
 
+func (PassportElementErrorSelfie) isPassportElementError() {}
+
+// MarshalJSON метод сериализации PassportElementErrorSelfie с заполненным полем Source
+func (v PassportElementErrorSelfie) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorSelfie
+	a := alias(v)
+	a.Source = "selfie"
+	return json.Marshal(a)
+}
+
 
 // Represents an issue with a document scan. The error is considered resolved when the file with the document scan changes.
 // 
@@ -6509,6 +8553,16 @@ type PassportElementErrorFile struct {
 	
 }
 
+func (PassportElementErrorFile) isPassportElementError() {}
+
+// MarshalJSON метод сериализации PassportElementErrorFile с заполненным полем Source
+func (v PassportElementErrorFile) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorFile
+	a := alias(v)
+	a.Source = "file"
+	return json.Marshal(a)
+}
+
 
 // Represents an issue with a list of scans. The error is considered resolved when the list of files containing the scans changes.
 // 
@@ -6529,6 +8583,16 @@ type PassportElementErrorFiles struct {
 	
 }
 
+func (PassportElementErrorFiles) isPassportElementError() {}
+
+// MarshalJSON метод сериализации PassportElementErrorFiles с заполненным полем Source
+func (v PassportElementErrorFiles) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorFiles
+	a := alias(v)
+	a.Source = "files"
+	return json.Marshal(a)
+}
+
 
 // Represents an issue with one of the files that constitute the translation of a document. The error is considered resolved when the file changes.
 // 
@@ -6549,6 +8613,16 @@ type PassportElementErrorTranslationFile struct {
 	
 }
 
+func (PassportElementErrorTranslationFile) isPassportElementError() {}
+
+// MarshalJSON метод сериализации PassportElementErrorTranslationFile с заполненным полем Source
+func (v PassportElementErrorTranslationFile) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorTranslationFile
+	a := alias(v)
+	a.Source = "translation_file"
+	return json.Marshal(a)
+}
+
 
 // Represents an issue with the translated version of a document. The error is considered resolved when a file with the document translation change.
 // 
@@ -6569,6 +8643,16 @@ type PassportElementErrorTranslationFiles struct {
 	
 }
 
+func (PassportElementErrorTranslationFiles) isPassportElementError() {}
+
+// MarshalJSON метод сериализации PassportElementErrorTranslationFiles с заполненным полем Source
+func (v PassportElementErrorTranslationFiles) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorTranslationFiles
+	a := alias(v)
+	a.Source = "translation_files"
+	return json.Marshal(a)
+}
+
 
 // Represents an issue in an unspecified place. The error is considered resolved when new data is added.
 // 
@@ -6589,6 +8673,16 @@ type PassportElementErrorUnspecified struct {
 	
 }
 
+func (PassportElementErrorUnspecified) isPassportElementError() {}
+
+// MarshalJSON метод сериализации PassportElementErrorUnspecified с заполненным полем Source
+func (v PassportElementErrorUnspecified) MarshalJSON() ([]byte, error) {
+	type alias PassportElementErrorUnspecified
+	a := alias(v)
+	a.Source = "unspecified"
+	return json.Marshal(a)
+}
+
 
 // This object represents a game. Use BotFather to create and edit games, their short names will act as unique identifiers.
 // 
@@ -6620,7 +8714,7 @@ type Game struct {
 // 
 // https://core.telegram.org/bots/api#callbackgame
 type CallbackGame struct {
-	  
+	 
 }
 
 
@@ -6643,3 +8737,26 @@ type GameHighScore struct {
 	
 }
 
+
+// unionDecoders функции десериализации объединений по их типу
+var unionDecoders = map[reflect.Type]func([]byte) (any, error){
+	reflect.TypeFor[MaybeInaccessibleMessage](): func(data []byte) (any, error) { return unmarshalMaybeInaccessibleMessage(data) },
+	reflect.TypeFor[MessageOrigin](): func(data []byte) (any, error) { return unmarshalMessageOrigin(data) },
+	reflect.TypeFor[PaidMedia](): func(data []byte) (any, error) { return unmarshalPaidMedia(data) },
+	reflect.TypeFor[BackgroundFill](): func(data []byte) (any, error) { return unmarshalBackgroundFill(data) },
+	reflect.TypeFor[BackgroundType](): func(data []byte) (any, error) { return unmarshalBackgroundType(data) },
+	reflect.TypeFor[ChatMember](): func(data []byte) (any, error) { return unmarshalChatMember(data) },
+	reflect.TypeFor[StoryAreaType](): func(data []byte) (any, error) { return unmarshalStoryAreaType(data) },
+	reflect.TypeFor[ReactionType](): func(data []byte) (any, error) { return unmarshalReactionType(data) },
+	reflect.TypeFor[OwnedGift](): func(data []byte) (any, error) { return unmarshalOwnedGift(data) },
+	reflect.TypeFor[BotCommandScope](): func(data []byte) (any, error) { return unmarshalBotCommandScope(data) },
+	reflect.TypeFor[MenuButton](): func(data []byte) (any, error) { return unmarshalMenuButton(data) },
+	reflect.TypeFor[ChatBoostSource](): func(data []byte) (any, error) { return unmarshalChatBoostSource(data) },
+	reflect.TypeFor[InputMedia](): func(data []byte) (any, error) { return unmarshalInputMedia(data) },
+	reflect.TypeFor[InputPaidMedia](): func(data []byte) (any, error) { return unmarshalInputPaidMedia(data) },
+	reflect.TypeFor[InputProfilePhoto](): func(data []byte) (any, error) { return unmarshalInputProfilePhoto(data) },
+	reflect.TypeFor[InputStoryContent](): func(data []byte) (any, error) { return unmarshalInputStoryContent(data) },
+	reflect.TypeFor[RevenueWithdrawalState](): func(data []byte) (any, error) { return unmarshalRevenueWithdrawalState(data) },
+	reflect.TypeFor[TransactionPartner](): func(data []byte) (any, error) { return unmarshalTransactionPartner(data) },
+	reflect.TypeFor[PassportElementError](): func(data []byte) (any, error) { return unmarshalPassportElementError(data) },
+}
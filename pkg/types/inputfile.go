@@ -0,0 +1,107 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+)
+
+// счётчик для генерации уникальных имён вложений multipart/form-data
+var attachCounter atomic.Uint64
+
+// InputFile структура файла для отправки.
+// Может содержать file_id файла на серверах Telegram, HTTP URL
+// или новый файл для загрузки через multipart/form-data (io.Reader или путь на диске).
+//
+// https://core.telegram.org/bots/api#inputfile
+type InputFile struct {
+	id     string
+	name   string
+	path   string
+	reader io.Reader
+	attach string
+}
+
+// InputFileID функция создания файла по file_id, уже существующему на серверах Telegram
+func InputFileID(fileId string) *InputFile {
+	return &InputFile{id: fileId}
+}
+
+// InputFileURL функция создания файла по HTTP URL, который Telegram скачает самостоятельно
+func InputFileURL(url string) *InputFile {
+	return &InputFile{id: url}
+}
+
+// InputFileReader функция создания файла для загрузки из io.Reader
+func InputFileReader(name string, r io.Reader) *InputFile {
+	return &InputFile{
+		name:   name,
+		reader: r,
+		attach: newAttachName(),
+	}
+}
+
+// InputFilePath функция создания файла для загрузки с диска
+func InputFilePath(path string) *InputFile {
+	return &InputFile{
+		name:   filepath.Base(path),
+		path:   path,
+		attach: newAttachName(),
+	}
+}
+
+func newAttachName() string {
+	return "file" + strconv.FormatUint(attachCounter.Add(1), 10)
+}
+
+// IsUpload метод проверки, требуется ли загрузка файла через multipart/form-data
+func (f *InputFile) IsUpload() bool {
+	return f != nil && (f.reader != nil || f.path != "")
+}
+
+// IsStream метод проверки, загружается ли файл из io.Reader, который нельзя прочитать повторно
+func (f *InputFile) IsStream() bool {
+	return f != nil && f.reader != nil
+}
+
+// Name метод получения имени загружаемого файла
+func (f *InputFile) Name() string { return f.name }
+
+// AttachName метод получения имени части multipart/form-data для ссылки вида attach://<имя>
+func (f *InputFile) AttachName() string { return f.attach }
+
+// String метод получения file_id или URL файла
+func (f *InputFile) String() string { return f.id }
+
+// Open метод получения содержимого загружаемого файла
+func (f *InputFile) Open() (io.ReadCloser, error) {
+	switch {
+	case f.reader != nil:
+		if rc, ok := f.reader.(io.ReadCloser); ok {
+			return rc, nil
+		}
+		return io.NopCloser(f.reader), nil
+	case f.path != "":
+		return os.Open(f.path)
+	}
+
+	return nil, errors.New("файл не предназначен для загрузки")
+}
+
+// MarshalJSON метод сериализации файла: file_id, URL или ссылка attach://<имя>
+func (f *InputFile) MarshalJSON() ([]byte, error) {
+	if f.IsUpload() {
+		return json.Marshal("attach://" + f.attach)
+	}
+	return json.Marshal(f.id)
+}
+
+// UnmarshalJSON метод десериализации файла из file_id или URL
+func (f *InputFile) UnmarshalJSON(data []byte) error {
+	*f = InputFile{}
+	return json.Unmarshal(data, &f.id)
+}
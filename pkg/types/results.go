@@ -0,0 +1,43 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// MessageOrBool результат методов, которые возвращают отредактированное сообщение
+// или True, если сообщение было отправлено через inline-режим
+type MessageOrBool struct {
+	// Отредактированное сообщение; nil для inline-сообщений
+	Message *Message
+
+	// Значение True, возвращаемое для inline-сообщений
+	Ok bool
+}
+
+// IsInline метод проверки, был ли результат получен для inline-сообщения
+func (r *MessageOrBool) IsInline() bool {
+	return r.Message == nil
+}
+
+// UnmarshalJSON метод десериализации результата из объекта Message или логического значения
+func (r *MessageOrBool) UnmarshalJSON(data []byte) error {
+	*r = MessageOrBool{}
+
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '{' {
+		r.Message = new(Message)
+		r.Ok = true
+		return json.Unmarshal(data, r.Message)
+	}
+
+	return json.Unmarshal(data, &r.Ok)
+}
+
+// MarshalJSON метод сериализации результата в объект Message или логическое значение
+func (r MessageOrBool) MarshalJSON() ([]byte, error) {
+	if r.Message != nil {
+		return json.Marshal(r.Message)
+	}
+	return json.Marshal(r.Ok)
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+)
+
+// Unmarshal функция десериализации JSON, поддерживающая объединения (например, ChatMember)
+// и срезы объединений в качестве значения верхнего уровня
+func Unmarshal(data []byte, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return json.Unmarshal(data, v)
+	}
+	rv = rv.Elem()
+
+	if decode, ok := unionDecoders[rv.Type()]; ok {
+		value, err := decode(data)
+		if err != nil {
+			return err
+		}
+		if value != nil {
+			rv.Set(reflect.ValueOf(value))
+		}
+		return nil
+	}
+
+	if rv.Kind() == reflect.Slice {
+		if decode, ok := unionDecoders[rv.Type().Elem()]; ok {
+			var raws []json.RawMessage
+			if err := json.Unmarshal(data, &raws); err != nil {
+				return err
+			}
+
+			slice := reflect.MakeSlice(rv.Type(), 0, len(raws))
+			for _, raw := range raws {
+				value, err := decode(raw)
+				if err != nil {
+					return err
+				}
+				if value != nil {
+					slice = reflect.Append(slice, reflect.ValueOf(value))
+				}
+			}
+			rv.Set(slice)
+
+			return nil
+		}
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+// isNull функция проверки отсутствующего или null значения
+func isNull(data []byte) bool {
+	data = bytes.TrimSpace(data)
+	return len(data) == 0 || bytes.Equal(data, []byte("null"))
+}
+
+// unmarshalSlice функция десериализации среза объединений
+func unmarshalSlice[T any](raws []json.RawMessage, unmarshal func([]byte) (T, error)) ([]T, error) {
+	if raws == nil {
+		return nil, nil
+	}
+
+	result := make([]T, 0, len(raws))
+	for _, raw := range raws {
+		v, err := unmarshal(raw)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, v)
+	}
+
+	return result, nil
+}
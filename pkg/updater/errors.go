@@ -0,0 +1,61 @@
+package updater
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/WORKHATERS/gote/pkg/core"
+)
+
+// ErrorAction тип действия получателя обновлений после ошибки
+type ErrorAction int
+
+const (
+	// ActionBackoff повторить после задержки по политике повторов
+	ActionBackoff ErrorAction = iota
+
+	// ActionContinue повторить сразу, без задержки
+	ActionContinue
+
+	// ActionStop остановить получение обновлений
+	ActionStop
+)
+
+// ErrorHandler тип обработчика ошибок получателя обновлений
+type ErrorHandler func(err *Error) ErrorAction
+
+// Error структура ошибки получателя обновлений
+type Error struct {
+	// Операция, при которой произошла ошибка:
+	// "getUpdates", "deleteWebhook", "setWebhook", "verify", "server", "offset"
+	Op string
+
+	// Номер ошибки подряд для этой операции, начиная с 1
+	Attempt int
+
+	// Исходная ошибка
+	Err error
+}
+
+// Error метод получения текста ошибки
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %v", e.Op, e.Err)
+}
+
+// Unwrap метод получения исходной ошибки
+func (e *Error) Unwrap() error { return e.Err }
+
+// Fatal метод проверки, что ошибку нельзя исправить повтором:
+// токен бота отозван (401) или обновления уже получает кто-то другой (409)
+func (e *Error) Fatal() bool {
+	return errors.Is(e.Err, core.ErrUnauthorized) || errors.Is(e.Err, core.ErrConflict)
+}
+
+// DefaultErrorHandler функция обработки ошибок по умолчанию:
+// при фатальных ошибках получение останавливается, иначе повторяется после задержки
+func DefaultErrorHandler(err *Error) ErrorAction {
+	if err.Fatal() {
+		return ActionStop
+	}
+	return ActionBackoff
+}
@@ -0,0 +1,285 @@
+package updater
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/WORKHATERS/gote/pkg/core"
+	"github.com/WORKHATERS/gote/pkg/types"
+)
+
+// FileUpdater структура получения обновлений из JSONL-файла или любого io.Reader (одно обновление на строку).
+// Позволяет воспроизводить записанные обновления локально, без обращения к Telegram.
+type FileUpdater struct {
+	bot        *core.Bot
+	r          io.Reader
+	closer     io.Closer
+	speed      float64
+	bufferSize int64
+
+	mu      sync.Mutex
+	err     error
+	skipped int
+}
+
+// FileUpdaterOption тип функциональных параметров
+type FileUpdaterOption func(*FileUpdater)
+
+// NewFileUpdater функция-конструктор для FileUpdater
+func NewFileUpdater(b *core.Bot, r io.Reader, opts ...FileUpdaterOption) *FileUpdater {
+	f := &FileUpdater{
+		bot:        b,
+		r:          r,
+		bufferSize: 100,
+	}
+
+	for _, opt := range opts {
+		opt(f)
+	}
+
+	return f
+}
+
+// OpenFileUpdater функция создания FileUpdater для JSONL-файла. Файл закрывается после чтения.
+func OpenFileUpdater(b *core.Bot, path string, opts ...FileUpdaterOption) (*FileUpdater, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	f := NewFileUpdater(b, file, opts...)
+	f.closer = file
+
+	return f, nil
+}
+
+// WithPacing функция включения воспроизведения с исходными интервалами между обновлениями,
+// вычисленными по их датам. speed - множитель скорости: 1 - реальное время, 2 - вдвое быстрее.
+// 0 - без задержек (по умолчанию).
+func WithPacing(speed float64) FileUpdaterOption {
+	return func(f *FileUpdater) { f.speed = speed }
+}
+
+// WithFileBufferSize функция установки размера буфера обновлений
+func WithFileBufferSize(size int64) FileUpdaterOption {
+	return func(f *FileUpdater) { f.bufferSize = size }
+}
+
+// Start метод чтения обновлений. Канал закрывается по окончании данных или при отмене контекста бота.
+// Строки, которые не удалось разобрать, пропускаются и логируются.
+func (f *FileUpdater) Start() <-chan types.Update {
+	ch := make(chan types.Update, f.bufferSize)
+
+	go func() {
+		defer close(ch)
+		if f.closer != nil {
+			defer f.closer.Close()
+		}
+
+		scanner := bufio.NewScanner(f.r)
+		scanner.Buffer(make([]byte, 64*1024), 16<<20)
+
+		var prev int64
+		for line := 1; scanner.Scan(); line++ {
+			data := scanner.Bytes()
+			if len(data) == 0 {
+				continue
+			}
+
+			var u types.Update
+			if err := json.Unmarshal(data, &u); err != nil {
+				f.bot.Logger().Warn("Пропущена строка с некорректным обновлением", "line", line, "error", err)
+				f.mu.Lock()
+				f.skipped++
+				f.mu.Unlock()
+				continue
+			}
+
+			if date := UpdateDate(u); f.speed > 0 && date > 0 {
+				if prev > 0 && date > prev {
+					delay := time.Duration(float64(time.Duration(date-prev)*time.Second) / f.speed)
+					select {
+					case <-time.After(delay):
+					case <-f.bot.Context().Done():
+						return
+					}
+				}
+				prev = date
+			}
+
+			select {
+			case ch <- u:
+			case <-f.bot.Context().Done():
+				return
+			}
+		}
+
+		if err := scanner.Err(); err != nil {
+			f.bot.Logger().Error("Ошибка чтения обновлений", "error", err)
+			f.mu.Lock()
+			f.err = err
+			f.mu.Unlock()
+		}
+	}()
+
+	return ch
+}
+
+// Err метод получения ошибки чтения, из-за которой обновления закончились раньше
+func (f *FileUpdater) Err() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.err
+}
+
+// Skipped метод получения количества пропущенных некорректных строк
+func (f *FileUpdater) Skipped() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.skipped
+}
+
+// UpdateDate функция получения даты обновления в формате Unix time или 0, если у обновления нет даты
+func UpdateDate(u types.Update) int64 {
+	for _, m := range []*types.Message{
+		u.Message, u.EditedMessage, u.ChannelPost, u.EditedChannelPost,
+		u.BusinessMessage, u.EditedBusinessMessage,
+	} {
+		if m != nil {
+			if m.EditDate > 0 {
+				return m.EditDate
+			}
+			return m.Date
+		}
+	}
+
+	switch {
+	case u.BusinessConnection != nil:
+		return u.BusinessConnection.Date
+	case u.MessageReaction != nil:
+		return u.MessageReaction.Date
+	case u.MessageReactionCount != nil:
+		return u.MessageReactionCount.Date
+	case u.MyChatMember != nil:
+		return u.MyChatMember.Date
+	case u.ChatMember != nil:
+		return u.ChatMember.Date
+	case u.ChatJoinRequest != nil:
+		return u.ChatJoinRequest.Date
+	}
+
+	return 0
+}
+
+// Tee структура получателя обновлений, который записывает каждое обновление другого получателя
+// в JSONL (одно обновление на строку), например для последующего воспроизведения через FileUpdater
+type Tee struct {
+	u Updater
+	w io.Writer
+
+	mu   sync.Mutex
+	err  error
+	done chan struct{}
+}
+
+// NewTee функция-конструктор для Tee
+func NewTee(u Updater, w io.Writer) *Tee {
+	return &Tee{u: u, w: w}
+}
+
+// Start метод получения обновлений с записью каждого обновления.
+// Ошибка записи не прерывает получение обновлений и доступна через Err.
+func (t *Tee) Start() <-chan types.Update {
+	in := t.u.Start()
+	ch := make(chan types.Update, cap(in))
+
+	t.mu.Lock()
+	done := make(chan struct{})
+	t.done = done
+	t.mu.Unlock()
+
+	go func() {
+		defer close(ch)
+
+		for u := range in {
+			t.write(u)
+
+			select {
+			case ch <- u:
+			case <-done:
+				// получатель больше не читает обновления: канал исходного получателя вычитывается,
+				// чтобы он мог завершиться
+				for range in {
+				}
+				return
+			}
+		}
+	}()
+
+	return ch
+}
+
+// Stop метод остановки: прекращает передачу обновлений и останавливает исходный получатель,
+// если он поддерживает остановку
+func (t *Tee) Stop(ctx context.Context) error {
+	t.mu.Lock()
+	if t.done != nil {
+		close(t.done)
+		t.done = nil
+	}
+	t.mu.Unlock()
+
+	if s, ok := t.u.(interface{ Stop(context.Context) error }); ok {
+		return s.Stop(ctx)
+	}
+
+	return nil
+}
+
+// write метод записи обновления строкой JSONL
+func (t *Tee) write(u types.Update) {
+	data, err := json.Marshal(u)
+	if err == nil {
+		t.mu.Lock()
+		_, err = t.w.Write(append(data, '\n'))
+		t.mu.Unlock()
+	}
+
+	if err != nil {
+		t.mu.Lock()
+		if t.err == nil {
+			t.err = fmt.Errorf("запись обновления %d: %w", u.UpdateId, err)
+		}
+		t.mu.Unlock()
+	}
+}
+
+// Ack метод подтверждения обработки обновления, если исходный получатель поддерживает подтверждение
+func (t *Tee) Ack(updateId int64) {
+	if ack, ok := t.u.(Acknowledger); ok {
+		ack.Ack(updateId)
+	}
+}
+
+// Err метод получения ошибки исходного получателя и первой ошибки записи
+func (t *Tee) Err() error {
+	var errs []error
+	if e, ok := t.u.(interface{ Err() error }); ok {
+		errs = append(errs, e.Err())
+	}
+
+	t.mu.Lock()
+	errs = append(errs, t.err)
+	t.mu.Unlock()
+
+	return errors.Join(errs...)
+}
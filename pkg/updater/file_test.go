@@ -0,0 +1,55 @@
+package updater_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/WORKHATERS/gote/pkg/gotetest"
+	"github.com/WORKHATERS/gote/pkg/updater"
+)
+
+func TestTeeStopWithoutReader(t *testing.T) {
+	s := gotetest.NewServer()
+	defer s.Close()
+
+	bot := s.Bot(context.Background())
+	defer bot.Stop()
+
+	for range 5 {
+		s.AddMessage(5, "msg")
+	}
+
+	tee := updater.NewTee(updater.NewPoller(bot, updater.WithTimeout(1), updater.WithUpdatesBufferSize(1)), io.Discard)
+	ch := tee.Start()
+
+	select {
+	case <-ch:
+	case <-time.After(5 * time.Second):
+		t.Fatal("обновление не получено")
+	}
+
+	// получатель перестаёт читать, а остановка всё равно завершается
+	time.Sleep(100 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	if err := tee.Stop(ctx); err != nil {
+		t.Fatalf("Stop: %v", err)
+	}
+
+	closed := make(chan struct{})
+	go func() {
+		for range ch {
+		}
+		close(closed)
+	}()
+
+	select {
+	case <-closed:
+	case <-time.After(2 * time.Second):
+		t.Fatal("канал Tee не закрыт после остановки")
+	}
+}
@@ -0,0 +1,79 @@
+package updater
+
+import (
+	"slices"
+
+	"github.com/WORKHATERS/gote/pkg/core"
+	"github.com/WORKHATERS/gote/pkg/types"
+)
+
+// UpdateKindSource интерфейс источника типов обновлений, которые обрабатывает приложение
+// (например, dispatcher.Dispatcher). Используется для вычисления allowed_updates.
+type UpdateKindSource interface {
+	UpdateKinds() []types.UpdateKind
+}
+
+// HandlerKindSource интерфейс источника типов обновлений, для которых явно зарегистрированы обработчики
+// (без обработчиков любых обновлений). Используется для предупреждений об обновлениях, которые не будут получены.
+type HandlerKindSource interface {
+	HandlerKinds() []types.UpdateKind
+}
+
+// WarnUndelivered функция предупреждения об обработчиках для типов обновлений, которые Telegram не пришлёт
+// получателю u. Если allowed_updates не задан, сравнение выполняется с types.DefaultUpdateKinds.
+// Получатели, не сообщающие allowed_updates (например, FileUpdater), не проверяются.
+func WarnUndelivered(logger core.Logger, handled []types.UpdateKind, u Updater) {
+	a, ok := u.(interface{ AllowedUpdates() []types.UpdateKind })
+	if !ok {
+		return
+	}
+
+	allowed := a.AllowedUpdates()
+	for _, k := range handled {
+		switch {
+		case allowed == nil && !slices.Contains(types.DefaultUpdateKinds, k):
+			logger.Warn("Обработчик зарегистрирован для типа обновлений, который Telegram не присылает без allowed_updates",
+				"kind", k)
+		case allowed != nil && !slices.Contains(allowed, k):
+			logger.Warn("Обработчик зарегистрирован для типа обновлений, который не указан в allowed_updates",
+				"kind", k, "allowed_updates", allowed)
+		}
+	}
+}
+
+// UpdateKindNames функция преобразования типов обновлений в значение allowed_updates
+func UpdateKindNames(kinds ...types.UpdateKind) []string {
+	names := make([]string, 0, len(kinds))
+	for _, k := range kinds {
+		if !slices.Contains(names, string(k)) {
+			names = append(names, string(k))
+		}
+	}
+	return names
+}
+
+// sourceKinds функция получения типов обновлений из источника.
+// Если источник не обрабатывает ни одного типа, используются типы по умолчанию: пустой allowed_updates
+// не отправляется, и Telegram продолжил бы использовать список из предыдущего запроса.
+func sourceKinds(src UpdateKindSource) []types.UpdateKind {
+	kinds := src.UpdateKinds()
+	if len(kinds) == 0 {
+		return slices.Clone(types.DefaultUpdateKinds)
+	}
+	return kinds
+}
+
+// effectiveKinds функция получения типов обновлений, которые Telegram будет присылать
+// для заданного allowed_updates. Для пустого списка возвращает nil: Telegram использует
+// значение из предыдущего вызова getUpdates или setWebhook, и оно неизвестно.
+func effectiveKinds(allowed []string) []types.UpdateKind {
+	if len(allowed) == 0 {
+		return nil
+	}
+
+	kinds := make([]types.UpdateKind, 0, len(allowed))
+	for _, a := range allowed {
+		kinds = append(kinds, types.UpdateKind(a))
+	}
+	return kinds
+}
@@ -0,0 +1,68 @@
+package updater_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/WORKHATERS/gote/pkg/gotetest"
+	"github.com/WORKHATERS/gote/pkg/types"
+	"github.com/WORKHATERS/gote/pkg/updater"
+)
+
+type kindSource []types.UpdateKind
+
+func (k kindSource) UpdateKinds() []types.UpdateKind { return k }
+
+func TestAllowedUpdatesFrom(t *testing.T) {
+	tests := []struct {
+		name   string
+		source kindSource
+		want   []types.UpdateKind
+	}{
+		{"обработчики", kindSource{types.UpdateKindMessage, types.UpdateKindChatMember}, []types.UpdateKind{types.UpdateKindMessage, types.UpdateKindChatMember}},
+		{"без обработчиков", nil, types.DefaultUpdateKinds},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := gotetest.NewServer()
+			defer s.Close()
+
+			bot := s.Bot(context.Background())
+			defer bot.Stop()
+
+			p := updater.NewPoller(bot, updater.WithTimeout(1), updater.WithAllowedUpdatesFrom(tt.source))
+			p.Start()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			for len(s.CallsTo("getUpdates")) == 0 && ctx.Err() == nil {
+				time.Sleep(10 * time.Millisecond)
+			}
+			if err := p.Stop(ctx); err != nil {
+				t.Fatal(err)
+			}
+
+			var params types.GetUpdates
+			call, _ := s.LastCall("getUpdates")
+			if err := call.Decode(&params); err != nil {
+				t.Fatal(err)
+			}
+
+			want := updater.UpdateKindNames(tt.want...)
+			if !slices.Equal(params.AllowedUpdates, want) {
+				t.Fatalf("allowed_updates %v, ожидалось %v", params.AllowedUpdates, want)
+			}
+			if got := p.AllowedUpdates(); !slices.Equal(got, tt.want) {
+				t.Fatalf("AllowedUpdates() = %v, ожидалось %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := updater.NewPoller(nil).AllowedUpdates(); got != nil {
+		t.Fatalf("без allowed_updates AllowedUpdates() = %v, ожидался nil", got)
+	}
+}
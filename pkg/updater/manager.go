@@ -0,0 +1,240 @@
+package updater
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+
+	"github.com/WORKHATERS/gote/pkg/core"
+	"github.com/WORKHATERS/gote/pkg/types"
+)
+
+// BotUpdate структура обновления с ботом, от имени которого оно получено
+type BotUpdate struct {
+	// Имя бота в Manager
+	Name string
+
+	// Бот, получивший обновление; через него нужно отвечать
+	Bot *core.Bot
+
+	// Обновление
+	Update types.Update
+
+	ack Acknowledger
+}
+
+// Ack метод подтверждения обработки обновления, если получатель обновлений бота поддерживает подтверждение
+func (u BotUpdate) Ack() {
+	if u.ack != nil {
+		u.ack.Ack(u.Update.UpdateId)
+	}
+}
+
+// Manager структура управления несколькими ботами в одном процессе.
+// Боты используют общий HTTP-клиент, для каждого запускается свой получатель обновлений,
+// а обновления всех ботов объединяются в один поток.
+type Manager struct {
+	ctx        context.Context
+	client     core.HTTPClient
+	botOptions []core.Option
+	newUpdater func(b *core.Bot) Updater
+	bufferSize int64
+
+	mu      sync.Mutex
+	bots    map[string]*managedBot
+	ch      chan BotUpdate
+	done    chan struct{}
+	wg      sync.WaitGroup
+	started bool
+}
+
+type managedBot struct {
+	bot     *core.Bot
+	updater Updater
+}
+
+// ManagerOption тип функциональных параметров
+type ManagerOption func(*Manager)
+
+// NewManager функция-конструктор для Manager
+func NewManager(ctx context.Context, opts ...ManagerOption) *Manager {
+	m := &Manager{
+		ctx:    ctx,
+		client: &http.Client{},
+		newUpdater: func(b *core.Bot) Updater {
+			return NewPoller(b)
+		},
+		bufferSize: 100,
+		bots:       make(map[string]*managedBot),
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m
+}
+
+// WithManagerHTTPClient функция установки общего HTTP-клиента для всех ботов
+func WithManagerHTTPClient(c core.HTTPClient) ManagerOption {
+	return func(m *Manager) { m.client = c }
+}
+
+// WithBotOptions функция установки параметров, применяемых ко всем ботам
+func WithBotOptions(opts ...core.Option) ManagerOption {
+	return func(m *Manager) { m.botOptions = append(m.botOptions, opts...) }
+}
+
+// WithUpdaterFactory функция установки способа создания получателя обновлений для бота.
+// По умолчанию используется Poller с параметрами по умолчанию.
+func WithUpdaterFactory(f func(b *core.Bot) Updater) ManagerOption {
+	return func(m *Manager) { m.newUpdater = f }
+}
+
+// WithManagerBufferSize функция установки размера буфера общего потока обновлений
+func WithManagerBufferSize(size int64) ManagerOption {
+	return func(m *Manager) { m.bufferSize = size }
+}
+
+// Add метод добавления бота. Если Manager уже запущен, получение обновлений бота начинается сразу.
+// opts применяются после общих параметров WithBotOptions.
+func (m *Manager) Add(name, token string, opts ...core.Option) (*core.Bot, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.bots[name]; ok {
+		return nil, fmt.Errorf("бот %q уже добавлен", name)
+	}
+
+	options := append([]core.Option{core.WithHTTPClient(m.client)}, m.botOptions...)
+	b := core.NewBot(m.ctx, token, append(options, opts...)...)
+
+	mb := &managedBot{bot: b, updater: m.newUpdater(b)}
+	m.bots[name] = mb
+
+	if m.started {
+		m.run(name, mb)
+	}
+
+	return b, nil
+}
+
+// Remove метод остановки и удаления бота
+func (m *Manager) Remove(name string) error {
+	m.mu.Lock()
+	mb, ok := m.bots[name]
+	delete(m.bots, name)
+	m.mu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("бот %q не найден", name)
+	}
+
+	mb.bot.Stop()
+	return nil
+}
+
+// Bot метод получения бота по имени
+func (m *Manager) Bot(name string) (*core.Bot, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	mb, ok := m.bots[name]
+	if !ok {
+		return nil, false
+	}
+	return mb.bot, true
+}
+
+// Updater метод получения получателя обновлений бота по имени
+func (m *Manager) Updater(name string) (Updater, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	mb, ok := m.bots[name]
+	if !ok {
+		return nil, false
+	}
+	return mb.updater, true
+}
+
+// Names метод получения имён всех ботов
+func (m *Manager) Names() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	names := make([]string, 0, len(m.bots))
+	for name := range m.bots {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// Start метод запуска получения обновлений всех ботов.
+// Повторный вызов во время работы возвращает тот же канал.
+func (m *Manager) Start() <-chan BotUpdate {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.started {
+		return m.ch
+	}
+
+	m.started = true
+	m.ch = make(chan BotUpdate, m.bufferSize)
+	m.done = make(chan struct{})
+
+	for name, mb := range m.bots {
+		m.run(name, mb)
+	}
+
+	return m.ch
+}
+
+// Stop метод остановки всех ботов. Канал обновлений закрывается после остановки всех получателей.
+// Контексты ботов отменяются, поэтому после Stop Manager нельзя запустить повторно.
+func (m *Manager) Stop() {
+	m.mu.Lock()
+	if !m.started {
+		m.mu.Unlock()
+		return
+	}
+	m.started = false
+	close(m.done)
+	for _, mb := range m.bots {
+		mb.bot.Stop()
+	}
+	ch := m.ch
+	m.mu.Unlock()
+
+	m.wg.Wait()
+	close(ch)
+}
+
+// run метод запуска получения обновлений бота. Вызывается под мьютексом.
+func (m *Manager) run(name string, mb *managedBot) {
+	updates := mb.updater.Start()
+	ack, _ := mb.updater.(Acknowledger)
+	ch, done := m.ch, m.done
+
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+
+		for u := range updates {
+			select {
+			case ch <- BotUpdate{Name: name, Bot: mb.bot, Update: u, ack: ack}:
+			case <-done:
+				return
+			}
+		}
+
+		if e, ok := mb.updater.(interface{ Err() error }); ok && e.Err() != nil {
+			mb.bot.Logger().Error("Получение обновлений бота остановлено", "bot", name, "error", e.Err())
+		}
+	}()
+}
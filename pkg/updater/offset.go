@@ -0,0 +1,108 @@
+package updater
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// OffsetStore интерфейс хранилища смещения getUpdates.
+// Позволяет продолжить получение обновлений после перезапуска с того же места.
+type OffsetStore interface {
+	// Load возвращает сохранённое смещение или 0, если оно ещё не сохранялось
+	Load(ctx context.Context) (int64, error)
+
+	// Save сохраняет смещение - идентификатор следующего ожидаемого обновления
+	Save(ctx context.Context, offset int64) error
+}
+
+// Acknowledger интерфейс получателя обновлений, которому нужно подтверждать обработку обновлений
+type Acknowledger interface {
+	Ack(updateId int64)
+}
+
+// MemoryOffsetStore структура хранилища смещения в памяти
+type MemoryOffsetStore struct {
+	mu     sync.Mutex
+	offset int64
+}
+
+// NewMemoryOffsetStore функция-конструктор для MemoryOffsetStore
+func NewMemoryOffsetStore() *MemoryOffsetStore {
+	return &MemoryOffsetStore{}
+}
+
+// Load метод получения смещения
+func (s *MemoryOffsetStore) Load(context.Context) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.offset, nil
+}
+
+// Save метод сохранения смещения
+func (s *MemoryOffsetStore) Save(_ context.Context, offset int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.offset = offset
+	return nil
+}
+
+// FileOffsetStore структура хранилища смещения в файле.
+// Запись атомарна: смещение пишется во временный файл, который затем переименовывается.
+type FileOffsetStore struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileOffsetStore функция-конструктор для FileOffsetStore
+func NewFileOffsetStore(path string) *FileOffsetStore {
+	return &FileOffsetStore{path: path}
+}
+
+// Load метод чтения смещения из файла
+func (s *FileOffsetStore) Load(context.Context) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+}
+
+// Save метод записи смещения в файл
+func (s *FileOffsetStore) Save(_ context.Context, offset int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.WriteString(strconv.FormatInt(offset, 10) + "\n"); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), s.path)
+}
@@ -0,0 +1,52 @@
+package updater
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/WORKHATERS/gote/pkg/gotetest"
+)
+
+func TestPollerAck(t *testing.T) {
+	for _, ackMode := range []bool{false, true} {
+		s := gotetest.NewServer()
+		bot := s.Bot(context.Background())
+
+		p := NewPoller(bot, WithTimeout(1), WithAckMode(ackMode), WithOffsetStore(NewMemoryOffsetStore()))
+		ch := p.Start()
+
+		const total = 50
+		for range total {
+			s.AddMessage(5, "msg")
+		}
+		for range total {
+			select {
+			case u := <-ch:
+				p.Ack(u.UpdateId)
+			case <-time.After(5 * time.Second):
+				t.Fatalf("ackMode=%v: обновление не получено", ackMode)
+			}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		if err := p.Stop(ctx); err != nil {
+			t.Fatalf("ackMode=%v: Stop: %v", ackMode, err)
+		}
+		cancel()
+
+		p.mu.Lock()
+		acked := len(p.acked)
+		p.mu.Unlock()
+		if acked != 0 {
+			t.Fatalf("ackMode=%v: после обработки в памяти осталось %d подтверждений", ackMode, acked)
+		}
+
+		if offset, _ := p.store.Load(context.Background()); offset != total+1 {
+			t.Fatalf("ackMode=%v: сохранено смещение %d, ожидалось %d", ackMode, offset, total+1)
+		}
+
+		bot.Stop()
+		s.Close()
+	}
+}
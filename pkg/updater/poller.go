@@ -1,6 +1,9 @@
 package updater
 
 import (
+	"context"
+	"errors"
+	"sync"
 	"time"
 
 	"github.com/WORKHATERS/gote/pkg/core"
@@ -17,7 +20,50 @@ type Poller struct {
 	bot          *core.Bot
 	params       types.GetUpdates
 	errorBackoff time.Duration
+	retry        *core.RetryPolicy
 	bufferSize   int64
+
+	store   OffsetStore
+	ackMode bool
+	acked   map[int64]struct{}
+	ackCh   chan struct{}
+
+	errorHandler ErrorHandler
+
+	deleteWebhook      bool
+	dropPendingUpdates bool
+	kindSource         UpdateKindSource
+
+	mu     sync.Mutex
+	ch     chan types.Update
+	cancel context.CancelFunc
+	done   chan struct{}
+	stats  PollerStats
+	err    error
+}
+
+// PollerStats структура состояния Poller
+type PollerStats struct {
+	// Запущен ли Poller
+	Running bool
+
+	// Время последнего успешного запроса getUpdates
+	LastPoll time.Time
+
+	// Количество ошибок getUpdates подряд
+	ErrorsInRow int
+
+	// Общее количество полученных обновлений
+	UpdatesReceived int64
+
+	// Текущее смещение getUpdates
+	Offset int64
+
+	// Количество обновлений в буфере, ещё не прочитанных получателем
+	Buffered int
+
+	// Размер буфера обновлений
+	BufferSize int64
 }
 
 // PollerOption тип функциональных параметров
@@ -33,6 +79,7 @@ func NewPoller(b *core.Bot, opts ...PollerOption) *Poller {
 		},
 		errorBackoff: 5 * time.Second,
 		bufferSize:   100,
+		errorHandler: DefaultErrorHandler,
 	}
 
 	for _, opt := range opts {
@@ -57,52 +104,432 @@ func WithAllowedUpdates(au []string) PollerOption {
 	return func(p *Poller) { p.params.AllowedUpdates = au }
 }
 
-// WithErrorBackoff функция установки значения времени ожидания при повторном запросе в случае ошибки
+// WithAllowedUpdatesFrom функция установки источника типов обновлений для allowed_updates.
+// Список вычисляется при каждом запуске, поэтому учитываются обработчики, добавленные после создания Poller.
+// Если источник не возвращает ни одного типа, передаётся types.DefaultUpdateKinds.
+func WithAllowedUpdatesFrom(src UpdateKindSource) PollerOption {
+	return func(p *Poller) { p.kindSource = src }
+}
+
+// WithErrorBackoff функция установки значения времени ожидания при повторном запросе в случае ошибки.
+// Используется, если не задана политика повторов.
 func WithErrorBackoff(d time.Duration) PollerOption {
 	return func(p *Poller) { p.errorBackoff = d }
 }
 
+// WithRetryPolicy функция установки политики задержек между повторными запросами при ошибках.
+// По умолчанию используется политика бота (core.WithRetry), а если она не задана - фиксированная задержка.
+func WithRetryPolicy(policy core.RetryPolicy) PollerOption {
+	return func(p *Poller) { p.retry = &policy }
+}
+
 // WithUpdatesBufferSize функция установки размера буфера обновлений
 func WithUpdatesBufferSize(size int64) PollerOption {
 	return func(p *Poller) { p.bufferSize = size }
 }
 
-// Start метод получения обвновлений
+// WithErrorHandler функция установки обработчика ошибок.
+// Обработчик решает, повторить запрос сразу, после задержки или остановить получение обновлений.
+// По умолчанию используется DefaultErrorHandler.
+func WithErrorHandler(h ErrorHandler) PollerOption {
+	return func(p *Poller) { p.errorHandler = h }
+}
+
+// WithDeleteWebhook функция включения удаления webhook при запуске.
+// Если у бота зарегистрирован webhook, getUpdates завершается ошибкой 409 Conflict,
+// поэтому Poller проверяет его через getWebhookInfo и удаляет. То же выполняется при получении 409.
+// dropPending - сбросить обновления, накопленные для webhook.
+func WithDeleteWebhook(dropPending bool) PollerOption {
+	return func(p *Poller) {
+		p.deleteWebhook = true
+		p.dropPendingUpdates = dropPending
+	}
+}
+
+// WithOffsetStore функция установки хранилища смещения.
+// Сохранённое смещение загружается при запуске и обновляется после каждой пачки обновлений.
+func WithOffsetStore(store OffsetStore) PollerOption {
+	return func(p *Poller) { p.store = store }
+}
+
+// WithAckMode функция включения режима подтверждения.
+// В этом режиме смещение сдвигается только после того, как получатель подтвердит
+// обработку всех обновлений пачки через Ack, что даёт доставку "хотя бы один раз"
+// в том числе между перезапусками (вместе с WithOffsetStore).
+func WithAckMode(on bool) PollerOption {
+	return func(p *Poller) { p.ackMode = on }
+}
+
+// Ack метод подтверждения обработки обновления в режиме подтверждения.
+// Вне режима подтверждения ничего не делает.
+func (p *Poller) Ack(updateId int64) {
+	if !p.ackMode {
+		return
+	}
+
+	p.mu.Lock()
+	if p.acked != nil {
+		p.acked[updateId] = struct{}{}
+	}
+	ackCh := p.ackCh
+	p.mu.Unlock()
+
+	select {
+	case ackCh <- struct{}{}:
+	default:
+	}
+}
+
+// Start метод получения обвновлений.
+// Повторный вызов во время работы возвращает тот же канал; после Stop запускает получение заново
+// с сохранённого смещения.
 func (p *Poller) Start() <-chan types.Update {
-	ch := make(chan types.Update, p.bufferSize)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.stats.Running {
+		return p.ch
+	}
+
+	if p.kindSource != nil {
+		p.params.AllowedUpdates = UpdateKindNames(sourceKinds(p.kindSource)...)
+	}
+
+	ctx, cancel := context.WithCancel(p.bot.Context())
+	p.ch = make(chan types.Update, p.bufferSize)
+	p.cancel = cancel
+	p.done = make(chan struct{})
+	p.acked = make(map[int64]struct{})
+	p.ackCh = make(chan struct{}, 1)
+	p.stats.Running = true
+	p.stats.ErrorsInRow = 0
+	p.err = nil
 
-	go func() {
-		defer close(ch)
+	go p.run(ctx, p.ch, p.done)
 
-		for {
-			if p.bot.Context().Err() != nil {
+	return p.ch
+}
+
+// Stop метод остановки получения обновлений: прерывает текущий long poll и ждёт,
+// пока получатель прочитает обновления, оставшиеся в буфере.
+// Возвращает ошибку контекста, если ожидание прервано.
+func (p *Poller) Stop(ctx context.Context) error {
+	p.mu.Lock()
+	if p.cancel == nil {
+		p.mu.Unlock()
+		return nil
+	}
+	p.cancel()
+	ch, done := p.ch, p.done
+	p.mu.Unlock()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+
+	for len(ch) > 0 {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+// Wait метод ожидания завершения горутины получения обновлений
+func (p *Poller) Wait() {
+	p.mu.Lock()
+	done := p.done
+	p.mu.Unlock()
+
+	if done != nil {
+		<-done
+	}
+}
+
+// AllowedUpdates метод получения типов обновлений, которые Telegram будет присылать с текущими параметрами
+// или nil, если allowed_updates не задан и Telegram использует значение из предыдущего запроса
+func (p *Poller) AllowedUpdates() []types.UpdateKind {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.kindSource != nil {
+		return effectiveKinds(UpdateKindNames(sourceKinds(p.kindSource)...))
+	}
+	return effectiveKinds(p.params.AllowedUpdates)
+}
+
+// Err метод получения ошибки, из-за которой обработчик ошибок остановил получение обновлений
+func (p *Poller) Err() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.err
+}
+
+// Stats метод получения снимка состояния Poller
+func (p *Poller) Stats() PollerStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	stats := p.stats
+	stats.Offset = p.params.Offset
+	stats.BufferSize = p.bufferSize
+	if p.ch != nil {
+		stats.Buffered = len(p.ch)
+	}
+
+	return stats
+}
+
+// run метод цикла получения обновлений
+func (p *Poller) run(ctx context.Context, ch chan types.Update, done chan struct{}) {
+	defer func() {
+		p.mu.Lock()
+		p.stats.Running = false
+		p.cancel()
+		p.cancel = nil
+		p.mu.Unlock()
+
+		close(ch)
+		close(done)
+	}()
+
+	if p.store != nil {
+		offset, err := p.store.Load(ctx)
+		if err != nil {
+			p.bot.Logger().Error("Ошибка загрузки смещения обновлений", "error", err)
+			if p.errorHandler(&Error{Op: "offset", Attempt: 1, Err: err}) == ActionStop {
+				p.stop(err)
 				return
 			}
+		} else {
+			p.mu.Lock()
+			p.params.Offset = max(p.params.Offset, offset)
+			p.mu.Unlock()
+		}
+	}
 
-			updates, err := p.bot.GetUpdates(p.bot.Context(), p.params)
-			if err != nil {
-				p.bot.Logger().Error("Ошибка получения обновлений", err)
-				select {
-				case <-time.After(p.errorBackoff):
-				case <-p.bot.Context().Done():
-					return
-				}
-				continue
+	if p.deleteWebhook {
+		if _, err := p.removeWebhook(ctx); err != nil {
+			p.bot.Logger().Error("Ошибка удаления webhook", "error", err)
+			if e := (&Error{Op: "deleteWebhook", Attempt: 1, Err: err}); p.errorHandler(e) == ActionStop {
+				p.stop(e)
+				return
 			}
+		}
+	}
+
+	for {
+		if ctx.Err() != nil {
+			return
+		}
 
-			if n := len(updates); n > 0 {
-				for _, u := range updates {
-					select {
-					case ch <- u:
-					case <-p.bot.Context().Done():
-						return
-					}
+		p.mu.Lock()
+		params := p.params
+		p.mu.Unlock()
+
+		// повторы getUpdates выполняет сам Poller с задержками политики, поэтому повторы бота отключаются
+		updates, err := p.bot.GetUpdates(core.WithoutRetry(ctx), params)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+
+			p.mu.Lock()
+			p.stats.ErrorsInRow++
+			errorsInRow := p.stats.ErrorsInRow
+			p.mu.Unlock()
+
+			p.bot.Logger().Error("Ошибка получения обновлений", "error", err)
+			if p.deleteWebhook && errors.Is(err, core.ErrConflict) {
+				if removed, _ := p.removeWebhook(ctx); removed {
+					continue
 				}
+			}
+			if !p.handleError(ctx, &Error{Op: "getUpdates", Attempt: errorsInRow, Err: err}) {
+				return
+			}
+			continue
+		}
+
+		p.mu.Lock()
+		p.stats.ErrorsInRow = 0
+		p.stats.LastPoll = time.Now()
+		p.stats.UpdatesReceived += int64(len(updates))
+		p.mu.Unlock()
+
+		if !p.deliver(ctx, ch, updates) {
+			return
+		}
+	}
+}
+
+// deliver метод передачи пачки обновлений получателю и сдвига смещения.
+// Без режима подтверждения смещение сдвигается после каждого переданного обновления,
+// поэтому при остановке посреди пачки переданные обновления не будут получены повторно.
+func (p *Poller) deliver(ctx context.Context, ch chan types.Update, updates []types.Update) bool {
+	if len(updates) == 0 {
+		return true
+	}
+
+	delivered := true
+	for _, u := range updates {
+		select {
+		case ch <- u:
+		case <-ctx.Done():
+			delivered = false
+		}
+		if !delivered {
+			break
+		}
+
+		if !p.ackMode {
+			p.setOffset(u.UpdateId + 1)
+		}
+	}
+
+	if delivered && p.ackMode {
+		if delivered = p.waitAcks(ctx, updates); delivered {
+			p.setOffset(updates[len(updates)-1].UpdateId + 1)
+		}
+	}
+
+	return p.saveOffset() && delivered
+}
+
+// removeWebhook метод удаления зарегистрированного webhook.
+// Возвращает true, если webhook был зарегистрирован и удалён.
+func (p *Poller) removeWebhook(ctx context.Context) (bool, error) {
+	info, err := p.bot.GetWebhookInfo(ctx, types.GetWebhookInfo{})
+	if err != nil {
+		return false, err
+	}
+
+	if info.Url == "" {
+		return false, nil
+	}
+
+	p.bot.Logger().Warn("Удаление webhook для получения обновлений через getUpdates",
+		"url", info.Url, "pending_update_count", info.PendingUpdateCount, "drop_pending_updates", p.dropPendingUpdates)
+
+	if _, err := p.bot.DeleteWebhook(ctx, types.DeleteWebhook{DropPendingUpdates: p.dropPendingUpdates}); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// setOffset метод установки смещения getUpdates
+func (p *Poller) setOffset(offset int64) {
+	p.mu.Lock()
+	p.params.Offset = offset
+	p.mu.Unlock()
+}
 
-				p.params.Offset = updates[n-1].UpdateId + 1
+// waitAcks метод ожидания подтверждения обработки всех обновлений пачки.
+// Возвращает false, если получение было остановлено раньше.
+func (p *Poller) waitAcks(ctx context.Context, updates []types.Update) bool {
+	for {
+		p.mu.Lock()
+		done := true
+		for _, u := range updates {
+			if _, ok := p.acked[u.UpdateId]; !ok {
+				done = false
+				break
 			}
 		}
-	}()
+		if done {
+			for _, u := range updates {
+				delete(p.acked, u.UpdateId)
+			}
+		}
+		ackCh := p.ackCh
+		p.mu.Unlock()
+
+		if done {
+			return true
+		}
+
+		select {
+		case <-ackCh:
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+// saveOffset метод сохранения текущего смещения в хранилище.
+// Возвращает false, если обработчик ошибок решил остановить получение обновлений.
+func (p *Poller) saveOffset() bool {
+	if p.store == nil {
+		return true
+	}
+
+	p.mu.Lock()
+	offset := p.params.Offset
+	p.mu.Unlock()
+
+	if err := p.store.Save(context.WithoutCancel(p.bot.Context()), offset); err != nil {
+		p.bot.Logger().Error("Ошибка сохранения смещения обновлений", "error", err)
+		if p.errorHandler(&Error{Op: "offset", Attempt: 1, Err: err}) == ActionStop {
+			p.stop(err)
+			return false
+		}
+	}
+
+	return true
+}
+
+// handleError метод обработки ошибки getUpdates согласно решению обработчика ошибок.
+// Возвращает false, если получение обновлений нужно завершить.
+func (p *Poller) handleError(ctx context.Context, err *Error) bool {
+	switch p.errorHandler(err) {
+	case ActionStop:
+		p.stop(err)
+		return false
+	case ActionContinue:
+		return ctx.Err() == nil
+	}
+
+	select {
+	case <-time.After(p.backoff(err.Err, err.Attempt)):
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// stop метод запоминания ошибки, из-за которой получение обновлений остановлено
+func (p *Poller) stop(err error) {
+	p.bot.Logger().Error("Получение обновлений остановлено", "error", err)
+
+	p.mu.Lock()
+	p.err = err
+	p.mu.Unlock()
+}
+
+// backoff метод вычисления задержки перед повторным запросом после attempt ошибок подряд
+func (p *Poller) backoff(err error, attempt int) time.Duration {
+	if p.retry != nil {
+		return p.retry.Delay(err, attempt)
+	}
+
+	if policy, ok := p.bot.RetryPolicy(); ok {
+		return policy.Delay(err, attempt)
+	}
+
+	var apiErr *core.APIError
+	if errors.As(err, &apiErr) && apiErr.RetryAfter() > p.errorBackoff {
+		return apiErr.RetryAfter()
+	}
 
-	return ch
+	return p.errorBackoff
 }
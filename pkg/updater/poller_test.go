@@ -0,0 +1,169 @@
+package updater_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/WORKHATERS/gote/pkg/core"
+	"github.com/WORKHATERS/gote/pkg/gotetest"
+	"github.com/WORKHATERS/gote/pkg/types"
+	"github.com/WORKHATERS/gote/pkg/updater"
+)
+
+func TestPollerRestart(t *testing.T) {
+	s := gotetest.NewServer()
+	defer s.Close()
+
+	bot := s.Bot(context.Background())
+	defer bot.Stop()
+
+	p := updater.NewPoller(bot, updater.WithTimeout(1), updater.WithUpdatesBufferSize(2))
+
+	const total = 30
+	go func() {
+		for i := range total {
+			s.AddMessage(5, "msg")
+			if i%5 == 0 {
+				time.Sleep(5 * time.Millisecond)
+			}
+		}
+	}()
+
+	var (
+		mu  sync.Mutex
+		got []int64
+	)
+	consume := func(ch <-chan types.Update, wg *sync.WaitGroup) {
+		defer wg.Done()
+		for u := range ch {
+			mu.Lock()
+			got = append(got, u.UpdateId)
+			mu.Unlock()
+			_ = p.Stats()
+		}
+	}
+
+	deadline := time.Now().Add(10 * time.Second)
+	for round := 0; ; round++ {
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go consume(p.Start(), &wg)
+
+		time.Sleep(20 * time.Millisecond)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		if err := p.Stop(ctx); err != nil {
+			cancel()
+			t.Fatalf("Stop: %v", err)
+		}
+		cancel()
+		wg.Wait()
+
+		if p.Stats().Running {
+			t.Fatal("Poller работает после Stop")
+		}
+
+		mu.Lock()
+		n := len(got)
+		mu.Unlock()
+		if n >= total {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("за %d перезапусков получено %d обновлений из %d", round+1, n, total)
+		}
+	}
+
+	// каждое обновление доставлено ровно один раз и по порядку
+	for i, id := range got {
+		if id != int64(i+1) {
+			t.Fatalf("обновления получены в порядке %v", got)
+		}
+	}
+	if offset := p.Stats().Offset; offset != total+1 {
+		t.Fatalf("смещение %d, ожидалось %d", offset, total+1)
+	}
+}
+
+func TestPollerStopsOnUnauthorized(t *testing.T) {
+	s := gotetest.NewServer()
+	defer s.Close()
+
+	bot := s.Bot(context.Background())
+	defer bot.Stop()
+
+	s.Respond("getUpdates", gotetest.Error(http.StatusUnauthorized, "Unauthorized"))
+
+	p := updater.NewPoller(bot, updater.WithTimeout(1))
+
+	select {
+	case _, ok := <-p.Start():
+		if ok {
+			t.Fatal("получено обновление при неверном токене")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("канал обновлений не закрыт после 401")
+	}
+
+	if err := p.Err(); !errors.Is(err, core.ErrUnauthorized) {
+		t.Fatalf("Err() = %v, ожидалась ErrUnauthorized", err)
+	}
+
+	// после остановки из-за ошибки Poller можно запустить снова
+	s.AddMessage(5, "снова")
+	select {
+	case u := <-p.Start():
+		if u.Message == nil || u.Message.Text != "снова" {
+			t.Fatalf("получено обновление %+v", u)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("обновление не получено после перезапуска")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := p.Stop(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestPollerSkipsBotRetries(t *testing.T) {
+	s := gotetest.NewServer()
+	defer s.Close()
+
+	policy := core.RetryPolicy{MaxAttempts: 3, MinDelay: time.Millisecond, MaxDelay: time.Millisecond}
+	bot := s.Bot(context.Background(), core.WithRetry(policy))
+	defer bot.Stop()
+
+	s.Respond("getUpdates", gotetest.Error(http.StatusInternalServerError, "Internal Server Error"))
+
+	var attempts []int
+	p := updater.NewPoller(bot, updater.WithTimeout(1), updater.WithErrorHandler(func(e *updater.Error) updater.ErrorAction {
+		attempts = append(attempts, e.Attempt)
+		return updater.ActionStop
+	}))
+	p.Start()
+
+	// если бот повторил запрос сам, ошибка не дойдёт до Poller и он продолжит работу
+	for deadline := time.Now().Add(time.Second); p.Err() == nil && time.Now().Before(deadline); {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := p.Stop(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// ошибка передана обработчику Poller после одного запроса, без повторов бота
+	if calls := s.CallsTo("getUpdates"); len(calls) != 1 {
+		t.Fatalf("getUpdates вызван %d раз, ожидался 1", len(calls))
+	}
+	if len(attempts) != 1 || attempts[0] != 1 {
+		t.Fatalf("попытки в обработчике ошибок: %v", attempts)
+	}
+}
@@ -0,0 +1,490 @@
+package updater
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/WORKHATERS/gote/pkg/core"
+	"github.com/WORKHATERS/gote/pkg/types"
+)
+
+var (
+	// ErrWebhookMismatch webhook, зарегистрированный в Telegram, не совпадает с конфигурацией
+	ErrWebhookMismatch = errors.New("webhook не совпадает с конфигурацией")
+
+	// ErrWebhookDelivery Telegram сообщает об ошибке доставки обновлений на webhook (last_error_message)
+	ErrWebhookDelivery = errors.New("ошибка доставки обновлений на webhook")
+)
+
+// SecretTokenHeader заголовок, в котором Telegram передаёт секретный токен webhook
+const SecretTokenHeader = "X-Telegram-Bot-Api-Secret-Token"
+
+// DefaultWebhookMaxBodySize максимальный размер тела запроса с обновлением по умолчанию
+const DefaultWebhookMaxBodySize = 1 << 20
+
+// Webhook структура для получения обновлений через webhook
+type Webhook struct {
+	bot    *core.Bot
+	params types.SetWebhook
+
+	listenAddr   string
+	certFile     string
+	keyFile      string
+	bufferSize   int64
+	maxBodySize  int64
+	stopTimeout  time.Duration
+	deleteOnStop bool
+	errorBackoff time.Duration
+	errorHandler ErrorHandler
+	verify       bool
+	kindSource   UpdateKindSource
+	verifyEvery  time.Duration
+
+	mu       sync.RWMutex
+	ch       chan types.Update
+	done     chan struct{}
+	stopOnce *sync.Once
+	inflight sync.WaitGroup
+	server   *http.Server
+	stopped  bool
+	err      error
+
+	registeredAt time.Time
+}
+
+// WebhookOption тип функциональных параметров
+type WebhookOption func(*Webhook)
+
+// NewWebhook функция-конструктор для Webhook.
+// url - публичный HTTPS адрес, на который Telegram будет отправлять обновления.
+func NewWebhook(b *core.Bot, url string, opts ...WebhookOption) *Webhook {
+	w := &Webhook{
+		bot: b,
+		params: types.SetWebhook{
+			Url: url,
+		},
+		bufferSize:   100,
+		maxBodySize:  DefaultWebhookMaxBodySize,
+		stopTimeout:  10 * time.Second,
+		deleteOnStop: true,
+		errorBackoff: 5 * time.Second,
+		errorHandler: DefaultErrorHandler,
+	}
+
+	for _, opt := range opts {
+		opt(w)
+	}
+
+	return w
+}
+
+// WithSecretToken функция установки секретного токена, проверяемого в заголовке X-Telegram-Bot-Api-Secret-Token
+func WithSecretToken(token string) WebhookOption {
+	return func(w *Webhook) { w.params.SecretToken = token }
+}
+
+// WithListenAddr функция установки адреса встроенного HTTP-сервера.
+// Если адрес не задан, сервер не запускается и Handler нужно подключить к своему роутеру.
+func WithListenAddr(addr string) WebhookOption {
+	return func(w *Webhook) { w.listenAddr = addr }
+}
+
+// WithTLS функция установки сертификата и ключа для встроенного HTTPS-сервера
+func WithTLS(certFile, keyFile string) WebhookOption {
+	return func(w *Webhook) {
+		w.certFile = certFile
+		w.keyFile = keyFile
+	}
+}
+
+// WithCertificate функция установки публичного сертификата, загружаемого в Telegram (для самоподписанных сертификатов)
+func WithCertificate(cert *types.InputFile) WebhookOption {
+	return func(w *Webhook) { w.params.Certificate = cert }
+}
+
+// WithWebhookAllowedUpdates функция установки значения принимаемых типов обновлений
+func WithWebhookAllowedUpdates(au []string) WebhookOption {
+	return func(w *Webhook) { w.params.AllowedUpdates = au }
+}
+
+// WithWebhookAllowedUpdatesFrom функция установки источника типов обновлений для allowed_updates.
+// Список вычисляется при запуске; если источник не возвращает ни одного типа, передаётся types.DefaultUpdateKinds.
+func WithWebhookAllowedUpdatesFrom(src UpdateKindSource) WebhookOption {
+	return func(w *Webhook) { w.kindSource = src }
+}
+
+// WithMaxConnections функция установки максимального количества одновременных соединений от Telegram
+func WithMaxConnections(n int64) WebhookOption {
+	return func(w *Webhook) { w.params.MaxConnections = n }
+}
+
+// WithIPAddress функция установки фиксированного IP-адреса для отправки запросов от Telegram
+func WithIPAddress(ip string) WebhookOption {
+	return func(w *Webhook) { w.params.IpAddress = ip }
+}
+
+// WithDropPendingUpdates функция установки сброса накопленных обновлений при регистрации webhook
+func WithDropPendingUpdates(drop bool) WebhookOption {
+	return func(w *Webhook) { w.params.DropPendingUpdates = drop }
+}
+
+// WithDeleteOnStop функция установки удаления webhook при остановке
+func WithDeleteOnStop(on bool) WebhookOption {
+	return func(w *Webhook) { w.deleteOnStop = on }
+}
+
+// WithWebhookBufferSize функция установки размера буфера обновлений
+func WithWebhookBufferSize(size int64) WebhookOption {
+	return func(w *Webhook) { w.bufferSize = size }
+}
+
+// WithWebhookMaxBodySize функция установки максимального размера тела запроса с обновлением в байтах.
+// Запросы большего размера отклоняются со статусом 413.
+func WithWebhookMaxBodySize(size int64) WebhookOption {
+	return func(w *Webhook) { w.maxBodySize = size }
+}
+
+// WithWebhookErrorHandler функция установки обработчика ошибок регистрации webhook и HTTP-сервера.
+// По умолчанию используется DefaultErrorHandler.
+func WithWebhookErrorHandler(h ErrorHandler) WebhookOption {
+	return func(w *Webhook) { w.errorHandler = h }
+}
+
+// WithWebhookVerify функция включения проверки webhook через getWebhookInfo после регистрации
+// и затем каждые interval. Расхождения с конфигурацией и last_error_message передаются обработчику ошибок
+// с операцией "verify". interval 0 - проверка только после регистрации.
+func WithWebhookVerify(interval time.Duration) WebhookOption {
+	return func(w *Webhook) {
+		w.verify = true
+		w.verifyEvery = interval
+	}
+}
+
+// Start метод регистрации webhook и получения обновлений
+func (w *Webhook) Start() <-chan types.Update {
+	w.mu.Lock()
+	w.ch = make(chan types.Update, w.bufferSize)
+	w.done = make(chan struct{})
+	w.stopOnce = &sync.Once{}
+	w.stopped = false
+	w.err = nil
+	if w.kindSource != nil {
+		w.params.AllowedUpdates = UpdateKindNames(sourceKinds(w.kindSource)...)
+	}
+	ch, done := w.ch, w.done
+	w.mu.Unlock()
+
+	if w.listenAddr != "" {
+		w.server = &http.Server{
+			Addr:    w.listenAddr,
+			Handler: w.Handler(),
+		}
+
+		go func() {
+			var err error
+			if w.certFile != "" {
+				err = w.server.ListenAndServeTLS(w.certFile, w.keyFile)
+			} else {
+				err = w.server.ListenAndServe()
+			}
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				w.bot.Logger().Error("Ошибка HTTP-сервера webhook", "error", err)
+				if e := (&Error{Op: "server", Attempt: 1, Err: err}); w.errorHandler(e) == ActionStop {
+					w.fail(e)
+				}
+			}
+		}()
+	}
+
+	if err := w.setWebhook(done); err != nil {
+		w.fail(err)
+		return ch
+	}
+
+	go func() {
+		select {
+		case <-w.bot.Context().Done():
+		case <-done:
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(w.bot.Context()), w.stopTimeout)
+		defer cancel()
+
+		if err := w.Stop(ctx); err != nil {
+			w.bot.Logger().Error("Ошибка остановки webhook", "error", err)
+		}
+	}()
+
+	return ch
+}
+
+// setWebhook метод регистрации webhook в Telegram с повторами по решению обработчика ошибок.
+// Первая попытка выполняется синхронно, повторы - в фоне.
+// Возвращает ошибку, если обработчик решил остановить webhook после первой попытки.
+func (w *Webhook) setWebhook(done <-chan struct{}) *Error {
+	_, err := w.bot.SetWebhook(w.bot.Context(), w.params)
+	if err == nil {
+		w.registered(done)
+		return nil
+	}
+
+	w.bot.Logger().Error("Ошибка установки webhook", "error", err)
+	e := &Error{Op: "setWebhook", Attempt: 1, Err: err}
+	action := w.errorHandler(e)
+	if action == ActionStop {
+		return e
+	}
+
+	go func() {
+		for attempt := 2; ; attempt++ {
+			delay := w.errorBackoff
+			if action == ActionContinue {
+				delay = 0
+			}
+
+			select {
+			case <-time.After(delay):
+			case <-done:
+				return
+			case <-w.bot.Context().Done():
+				return
+			}
+
+			_, err := w.bot.SetWebhook(w.bot.Context(), w.params)
+			if err == nil {
+				w.registered(done)
+				return
+			}
+
+			w.bot.Logger().Error("Ошибка установки webhook", "error", err)
+			e := &Error{Op: "setWebhook", Attempt: attempt, Err: err}
+			if action = w.errorHandler(e); action == ActionStop {
+				w.fail(e)
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// registered метод запуска проверки webhook после успешной регистрации
+func (w *Webhook) registered(done <-chan struct{}) {
+	w.mu.Lock()
+	w.registeredAt = time.Now()
+	w.mu.Unlock()
+
+	if !w.verify {
+		return
+	}
+
+	go func() {
+		for attempt := 1; ; {
+			if _, err := w.Verify(w.bot.Context()); err != nil {
+				w.bot.Logger().Warn("Проверка webhook не пройдена", "error", err)
+				if e := (&Error{Op: "verify", Attempt: attempt, Err: err}); w.errorHandler(e) == ActionStop {
+					w.fail(e)
+					return
+				}
+				attempt++
+			} else {
+				attempt = 1
+			}
+
+			if w.verifyEvery <= 0 {
+				return
+			}
+
+			select {
+			case <-time.After(w.verifyEvery):
+			case <-done:
+				return
+			case <-w.bot.Context().Done():
+				return
+			}
+		}
+	}()
+}
+
+// Verify метод проверки webhook через getWebhookInfo: адрес, allowed_updates и max_connections
+// должны совпадать с конфигурацией (ErrWebhookMismatch), а Telegram не должен сообщать
+// об ошибках доставки после регистрации (ErrWebhookDelivery).
+func (w *Webhook) Verify(ctx context.Context) (*types.WebhookInfo, error) {
+	info, err := w.bot.GetWebhookInfo(ctx, types.GetWebhookInfo{})
+	if err != nil {
+		return nil, err
+	}
+
+	var diffs []string
+	if info.Url != w.params.Url {
+		diffs = append(diffs, fmt.Sprintf("url %q, ожидался %q", info.Url, w.params.Url))
+	}
+	if want := w.params.AllowedUpdates; len(want) > 0 && !sameUpdates(info.AllowedUpdates, want) {
+		diffs = append(diffs, fmt.Sprintf("allowed_updates %v, ожидались %v", info.AllowedUpdates, want))
+	}
+	if want := w.params.MaxConnections; want > 0 && info.MaxConnections != want {
+		diffs = append(diffs, fmt.Sprintf("max_connections %d, ожидалось %d", info.MaxConnections, want))
+	}
+
+	var errs []error
+	if len(diffs) > 0 {
+		errs = append(errs, fmt.Errorf("%w: %s", ErrWebhookMismatch, strings.Join(diffs, "; ")))
+	}
+
+	w.mu.RLock()
+	registeredAt := w.registeredAt
+	w.mu.RUnlock()
+
+	if info.LastErrorMessage != "" && !time.Unix(info.LastErrorDate, 0).Before(registeredAt.Truncate(time.Second)) {
+		errs = append(errs, fmt.Errorf("%w: %s (%s, ожидает обновлений: %d)", ErrWebhookDelivery,
+			info.LastErrorMessage, time.Unix(info.LastErrorDate, 0).Format(time.RFC3339), info.PendingUpdateCount))
+	}
+
+	return info, errors.Join(errs...)
+}
+
+// sameUpdates функция сравнения списков типов обновлений без учёта порядка
+func sameUpdates(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
+
+// fail метод остановки webhook из-за ошибки
+func (w *Webhook) fail(err *Error) {
+	w.bot.Logger().Error("Получение обновлений остановлено", "error", err)
+
+	w.mu.Lock()
+	w.err = err
+	w.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(w.bot.Context()), w.stopTimeout)
+	defer cancel()
+
+	if err := w.Stop(ctx); err != nil {
+		w.bot.Logger().Error("Ошибка остановки webhook", "error", err)
+	}
+}
+
+// AllowedUpdates метод получения типов обновлений, которые Telegram будет присылать с текущими параметрами
+// или nil, если allowed_updates не задан и Telegram использует значение из предыдущего запроса
+func (w *Webhook) AllowedUpdates() []types.UpdateKind {
+	if w.kindSource != nil {
+		return effectiveKinds(UpdateKindNames(sourceKinds(w.kindSource)...))
+	}
+	return effectiveKinds(w.params.AllowedUpdates)
+}
+
+// Err метод получения ошибки, из-за которой обработчик ошибок остановил webhook
+func (w *Webhook) Err() error {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	return w.err
+}
+
+// Stop метод остановки webhook: удаление webhook в Telegram, остановка сервера и закрытие канала обновлений
+func (w *Webhook) Stop(ctx context.Context) error {
+	w.mu.RLock()
+	done, once := w.done, w.stopOnce
+	w.mu.RUnlock()
+
+	if once == nil {
+		return nil
+	}
+
+	// сначала освобождаются обработчики, ожидающие места в канале, чтобы они не держали остановку
+	once.Do(func() { close(done) })
+
+	w.mu.Lock()
+	if w.stopped {
+		w.mu.Unlock()
+		return nil
+	}
+	w.stopped = true
+	ch := w.ch
+	w.mu.Unlock()
+
+	// новые запросы после stopped не передают обновления, поэтому после ожидания в канал никто не пишет
+	w.inflight.Wait()
+
+	var errs []error
+
+	if w.deleteOnStop {
+		if _, err := w.bot.DeleteWebhook(ctx, types.DeleteWebhook{}); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if w.server != nil {
+		if err := w.server.Shutdown(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	close(ch)
+
+	return errors.Join(errs...)
+}
+
+// Handler метод получения HTTP-обработчика для подключения к своему роутеру
+func (w *Webhook) Handler() http.Handler {
+	return http.HandlerFunc(w.serveHTTP)
+}
+
+func (w *Webhook) serveHTTP(rw http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		rw.Header().Set("Allow", http.MethodPost)
+		http.Error(rw, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	if secret := w.params.SecretToken; secret != "" {
+		got := r.Header.Get(SecretTokenHeader)
+		if subtle.ConstantTimeCompare([]byte(got), []byte(secret)) != 1 {
+			http.Error(rw, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+	}
+
+	var update types.Update
+	if err := json.NewDecoder(http.MaxBytesReader(rw, r.Body, w.maxBodySize)).Decode(&update); err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			http.Error(rw, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(rw, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	w.mu.RLock()
+	if w.ch == nil || w.stopped {
+		w.mu.RUnlock()
+		http.Error(rw, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+		return
+	}
+	ch, done := w.ch, w.done
+	w.inflight.Add(1)
+	w.mu.RUnlock()
+
+	defer w.inflight.Done()
+
+	select {
+	case ch <- update:
+		rw.WriteHeader(http.StatusOK)
+	case <-done:
+		http.Error(rw, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+	case <-r.Context().Done():
+	}
+}
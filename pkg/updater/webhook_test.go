@@ -0,0 +1,124 @@
+package updater_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/WORKHATERS/gote/pkg/gotetest"
+	"github.com/WORKHATERS/gote/pkg/updater"
+)
+
+func TestWebhookStopWithBlockedHandler(t *testing.T) {
+	s := gotetest.NewServer()
+	defer s.Close()
+
+	bot := s.Bot(context.Background())
+	defer bot.Stop()
+
+	wh := updater.NewWebhook(bot, "https://example.com/hook", updater.WithWebhookBufferSize(1))
+	ts := httptest.NewServer(wh.Handler())
+	defer ts.Close()
+
+	ch := wh.Start()
+
+	post := func(id int) <-chan int {
+		status := make(chan int, 1)
+		go func() {
+			body := strings.NewReader(`{"update_id":` + strconv.Itoa(id) + `}`)
+			resp, err := http.Post(ts.URL, "application/json", body)
+			if err != nil {
+				status <- 0
+				return
+			}
+			resp.Body.Close()
+			status <- resp.StatusCode
+		}()
+		return status
+	}
+
+	// первое обновление занимает буфер, второе ждёт места в канале
+	if code := <-post(1); code != http.StatusOK {
+		t.Fatalf("первое обновление: статус %d, ожидался 200", code)
+	}
+	blocked := post(2)
+	time.Sleep(100 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	stopped := make(chan error, 1)
+	go func() { stopped <- wh.Stop(ctx) }()
+
+	select {
+	case err := <-stopped:
+		if err != nil {
+			t.Fatalf("Stop: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Stop не завершился при заблокированном обработчике")
+	}
+
+	if code := <-blocked; code != http.StatusServiceUnavailable {
+		t.Fatalf("заблокированное обновление: статус %d, ожидался 503", code)
+	}
+	if code := <-post(3); code != http.StatusServiceUnavailable {
+		t.Fatalf("обновление после остановки: статус %d, ожидался 503", code)
+	}
+
+	n := 0
+	for range ch {
+		n++
+	}
+	if n != 1 {
+		t.Fatalf("получено %d обновлений, ожидалось 1", n)
+	}
+
+	if calls := s.CallsTo("deleteWebhook"); len(calls) != 1 {
+		t.Fatalf("deleteWebhook вызван %d раз, ожидался 1", len(calls))
+	}
+}
+
+func TestWebhookMaxBodySize(t *testing.T) {
+	s := gotetest.NewServer()
+	defer s.Close()
+
+	bot := s.Bot(context.Background())
+	defer bot.Stop()
+
+	wh := updater.NewWebhook(bot, "https://example.com/hook", updater.WithWebhookMaxBodySize(1024))
+	ts := httptest.NewServer(wh.Handler())
+	defer ts.Close()
+
+	ch := wh.Start()
+
+	post := func(body string) int {
+		resp, err := http.Post(ts.URL, "application/json", strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	big := `{"update_id":1,"message":{"text":"` + strings.Repeat("x", 2048) + `"}}`
+	if code := post(big); code != http.StatusRequestEntityTooLarge {
+		t.Fatalf("большое обновление: статус %d, ожидался 413", code)
+	}
+	if code := post(`{"update_id":2}`); code != http.StatusOK {
+		t.Fatalf("обновление: статус %d, ожидался 200", code)
+	}
+	if u := <-ch; u.UpdateId != 2 {
+		t.Fatalf("получено обновление %d, ожидалось 2", u.UpdateId)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := wh.Stop(ctx); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,297 @@
+package updater
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/WORKHATERS/gote/pkg/core"
+	"github.com/WORKHATERS/gote/pkg/types"
+)
+
+// UpdateHandler тип функции обработки одного обновления
+type UpdateHandler func(ctx context.Context, u types.Update) error
+
+// WorkerPool структура параллельной обработки обновлений ограниченным числом воркеров.
+// Обновления с одинаковым ключом (одного чата или пользователя) обрабатываются строго по порядку,
+// обновления разных чатов - параллельно. Когда очереди заполнены, чтение обновлений приостанавливается.
+type WorkerPool struct {
+	bot     *core.Bot
+	handler UpdateHandler
+
+	workers      int
+	maxQueued    int
+	timeout      time.Duration
+	orderKey     func(types.Update) string
+	errorHandler func(u types.Update, err error)
+	kindSource   HandlerKindSource
+
+	mu     sync.Mutex
+	queues map[string][]types.Update
+}
+
+// WorkerPoolOption тип функциональных параметров
+type WorkerPoolOption func(*WorkerPool)
+
+// NewWorkerPool функция-конструктор для WorkerPool
+func NewWorkerPool(b *core.Bot, h UpdateHandler, opts ...WorkerPoolOption) *WorkerPool {
+	p := &WorkerPool{
+		bot:       b,
+		handler:   h,
+		workers:   10,
+		maxQueued: 1000,
+		orderKey:  OrderKey,
+	}
+
+	p.errorHandler = func(u types.Update, err error) {
+		p.bot.Logger().Error("Ошибка обработки обновления", "update_id", u.UpdateId, "error", err)
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+// WithWorkers функция установки максимального количества одновременно обрабатываемых обновлений
+func WithWorkers(n int) WorkerPoolOption {
+	return func(p *WorkerPool) { p.workers = max(n, 1) }
+}
+
+// WithMaxQueued функция установки максимального количества обновлений, ожидающих в очередях чатов.
+// Когда очереди заполнены, новые обновления не читаются из Updater, пока место не освободится.
+func WithMaxQueued(n int) WorkerPoolOption {
+	return func(p *WorkerPool) { p.maxQueued = max(n, 1) }
+}
+
+// WithUpdateTimeout функция установки времени обработки одного обновления; 0 - без ограничения
+func WithUpdateTimeout(d time.Duration) WorkerPoolOption {
+	return func(p *WorkerPool) { p.timeout = d }
+}
+
+// WithOrderKey функция установки ключа упорядочивания обновлений.
+// Обновления с одинаковым ключом обрабатываются по порядку; пустой ключ - без упорядочивания.
+func WithOrderKey(f func(types.Update) string) WorkerPoolOption {
+	return func(p *WorkerPool) { p.orderKey = f }
+}
+
+// WithUpdateErrorHandler функция установки обработчика ошибок и паник при обработке обновлений.
+// По умолчанию ошибки логируются.
+func WithUpdateErrorHandler(h func(u types.Update, err error)) WorkerPoolOption {
+	return func(p *WorkerPool) { p.errorHandler = h }
+}
+
+// WithHandlerKinds функция установки источника типов обновлений, для которых зарегистрированы обработчики
+// (например, dispatcher.Dispatcher). При запуске Run предупреждает в логе об обработчиках,
+// которые не получат обновлений из-за allowed_updates.
+func WithHandlerKinds(src HandlerKindSource) WorkerPoolOption {
+	return func(p *WorkerPool) { p.kindSource = src }
+}
+
+// Run метод обработки обновлений из Updater до закрытия канала или отмены контекста.
+// Дожидается завершения обновлений, которые уже обрабатываются.
+// Если Updater поддерживает подтверждение (Acknowledger), каждое обновление подтверждается после обработки.
+func (p *WorkerPool) Run(ctx context.Context, u Updater) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	p.mu.Lock()
+	p.queues = make(map[string][]types.Update)
+	p.mu.Unlock()
+
+	ack, _ := u.(Acknowledger)
+	sem := make(chan struct{}, p.workers)
+	queued := make(chan struct{}, p.maxQueued)
+	var wg sync.WaitGroup
+
+	updates := u.Start()
+	if p.kindSource != nil {
+		WarnUndelivered(p.bot.Logger(), p.kindSource.HandlerKinds(), u)
+	}
+
+loop:
+	for {
+		select {
+		case <-ctx.Done():
+			break loop
+		case update, ok := <-updates:
+			if !ok {
+				break loop
+			}
+			p.dispatch(ctx, update, ack, sem, queued, &wg)
+		}
+	}
+
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if e, ok := u.(interface{ Err() error }); ok {
+		return e.Err()
+	}
+
+	return nil
+}
+
+// dispatch метод передачи обновления воркеру.
+// Если обновления с тем же ключом уже обрабатываются, обновление ставится в их очередь;
+// если все места в очередях (queued) заняты, метод ждёт, пока воркеры их не освободят.
+func (p *WorkerPool) dispatch(ctx context.Context, u types.Update, ack Acknowledger, sem, queued chan struct{}, wg *sync.WaitGroup) {
+	key := p.orderKey(u)
+	if key == "" {
+		key = "update:" + strconv.FormatInt(u.UpdateId, 10)
+	}
+
+	p.mu.Lock()
+	if _, ok := p.queues[key]; ok {
+		p.mu.Unlock()
+
+		select {
+		case queued <- struct{}{}:
+		case <-ctx.Done():
+			return
+		}
+
+		p.mu.Lock()
+		if queue, ok := p.queues[key]; ok {
+			p.queues[key] = append(queue, u)
+			p.mu.Unlock()
+			return
+		}
+
+		// пока ожидали места, очередь обработана: обновление передаётся новому воркеру
+		<-queued
+	}
+	p.queues[key] = nil
+	p.mu.Unlock()
+
+	select {
+	case sem <- struct{}{}:
+	case <-ctx.Done():
+		p.mu.Lock()
+		delete(p.queues, key)
+		p.mu.Unlock()
+		return
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer func() { <-sem }()
+
+		for {
+			p.process(ctx, u)
+			if ack != nil {
+				ack.Ack(u.UpdateId)
+			}
+
+			p.mu.Lock()
+			queue := p.queues[key]
+			if len(queue) == 0 || ctx.Err() != nil {
+				delete(p.queues, key)
+				p.mu.Unlock()
+				for range queue {
+					<-queued
+				}
+				return
+			}
+			u, p.queues[key] = queue[0], queue[1:]
+			p.mu.Unlock()
+			<-queued
+		}
+	}()
+}
+
+// process метод обработки одного обновления с ограничением времени и перехватом паники
+func (p *WorkerPool) process(ctx context.Context, u types.Update) {
+	if p.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.timeout)
+		defer cancel()
+	}
+
+	err := func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("паника при обработке обновления: %v\n%s", r, debug.Stack())
+			}
+		}()
+		return p.handler(ctx, u)
+	}()
+
+	if err != nil && p.errorHandler != nil {
+		p.errorHandler(u, err)
+	}
+}
+
+// OrderKey функция получения ключа упорядочивания по умолчанию:
+// чат для сообщений и событий чата, пользователь для inline-запросов, callback-запросов и платежей.
+// Для обновлений без чата и пользователя (poll) возвращает пустую строку.
+func OrderKey(u types.Update) string {
+	chat := func(c *types.Chat) string {
+		if c == nil {
+			return ""
+		}
+		return "chat:" + strconv.FormatInt(c.Id, 10)
+	}
+	user := func(u *types.User) string {
+		if u == nil {
+			return ""
+		}
+		return "user:" + strconv.FormatInt(u.Id, 10)
+	}
+
+	switch {
+	case u.Message != nil:
+		return chat(u.Message.Chat)
+	case u.EditedMessage != nil:
+		return chat(u.EditedMessage.Chat)
+	case u.ChannelPost != nil:
+		return chat(u.ChannelPost.Chat)
+	case u.EditedChannelPost != nil:
+		return chat(u.EditedChannelPost.Chat)
+	case u.BusinessConnection != nil:
+		return user(u.BusinessConnection.User)
+	case u.BusinessMessage != nil:
+		return chat(u.BusinessMessage.Chat)
+	case u.EditedBusinessMessage != nil:
+		return chat(u.EditedBusinessMessage.Chat)
+	case u.DeletedBusinessMessages != nil:
+		return chat(u.DeletedBusinessMessages.Chat)
+	case u.MessageReaction != nil:
+		return chat(u.MessageReaction.Chat)
+	case u.MessageReactionCount != nil:
+		return chat(u.MessageReactionCount.Chat)
+	case u.InlineQuery != nil:
+		return user(u.InlineQuery.From)
+	case u.ChosenInlineResult != nil:
+		return user(u.ChosenInlineResult.From)
+	case u.CallbackQuery != nil:
+		return user(u.CallbackQuery.From)
+	case u.ShippingQuery != nil:
+		return user(u.ShippingQuery.From)
+	case u.PreCheckoutQuery != nil:
+		return user(u.PreCheckoutQuery.From)
+	case u.PurchasedPaidMedia != nil:
+		return user(u.PurchasedPaidMedia.From)
+	case u.PollAnswer != nil:
+		return user(u.PollAnswer.User)
+	case u.MyChatMember != nil:
+		return chat(u.MyChatMember.Chat)
+	case u.ChatMember != nil:
+		return chat(u.ChatMember.Chat)
+	case u.ChatJoinRequest != nil:
+		return chat(u.ChatJoinRequest.Chat)
+	case u.ChatBoost != nil:
+		return chat(u.ChatBoost.Chat)
+	case u.RemovedChatBoost != nil:
+		return chat(u.RemovedChatBoost.Chat)
+	}
+
+	return ""
+}
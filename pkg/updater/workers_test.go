@@ -0,0 +1,110 @@
+package updater_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/WORKHATERS/gote/pkg/gotetest"
+	"github.com/WORKHATERS/gote/pkg/types"
+	"github.com/WORKHATERS/gote/pkg/updater"
+)
+
+// chanUpdater получатель обновлений из канала, которым управляет тест
+type chanUpdater chan types.Update
+
+func (c chanUpdater) Start() <-chan types.Update { return c }
+
+func chatUpdate(id, chatId int64) types.Update {
+	return types.Update{UpdateId: id, Message: &types.Message{Chat: &types.Chat{Id: chatId}}}
+}
+
+func TestWorkerPoolOrderAndBackpressure(t *testing.T) {
+	s := gotetest.NewServer()
+	defer s.Close()
+
+	bot := s.Bot(context.Background())
+	defer bot.Stop()
+
+	release := make(chan struct{})
+	var (
+		mu        sync.Mutex
+		processed = make(map[int64][]int64)
+	)
+
+	pool := updater.NewWorkerPool(bot, func(ctx context.Context, u types.Update) error {
+		if u.Message.Chat.Id == 1 {
+			<-release
+		}
+		mu.Lock()
+		processed[u.Message.Chat.Id] = append(processed[u.Message.Chat.Id], u.UpdateId)
+		mu.Unlock()
+		return nil
+	}, updater.WithWorkers(2), updater.WithMaxQueued(3))
+
+	in := make(chanUpdater)
+	done := make(chan error, 1)
+	go func() { done <- pool.Run(context.Background(), in) }()
+
+	send := func(u types.Update) bool {
+		select {
+		case in <- u:
+			return true
+		case <-time.After(200 * time.Millisecond):
+			return false
+		}
+	}
+
+	// первое обновление чата 1 обрабатывается, следующие три занимают все места в очередях
+	for id := int64(1); id <= 4; id++ {
+		if !send(chatUpdate(id, 1)) {
+			t.Fatalf("обновление %d не принято при свободной очереди", id)
+		}
+	}
+
+	// другой чат обрабатывается параллельно, пока чат 1 занят
+	if !send(chatUpdate(5, 2)) {
+		t.Fatal("обновление другого чата не принято")
+	}
+
+	// обновление сверх лимита прочитано, но ждёт места в очереди, поэтому следующее не читается
+	if !send(chatUpdate(6, 1)) {
+		t.Fatal("обновление не прочитано из Updater")
+	}
+	if send(chatUpdate(7, 1)) {
+		t.Fatal("чтение обновлений не приостановлено при заполненной очереди")
+	}
+
+	close(release)
+	if !send(chatUpdate(7, 1)) {
+		t.Fatal("обновление не принято после освобождения очереди")
+	}
+	close(in)
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run не завершился после закрытия канала")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	want := []int64{1, 2, 3, 4, 6, 7}
+	got := processed[1]
+	if len(got) != len(want) {
+		t.Fatalf("чат 1: обработаны обновления %v, ожидались %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("чат 1: обработаны обновления %v, ожидались %v", got, want)
+		}
+	}
+	if len(processed[2]) != 1 {
+		t.Fatalf("чат 2: обработаны обновления %v", processed[2])
+	}
+}
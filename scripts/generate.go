@@ -22,6 +22,43 @@ type tgObject struct {
 	List               []string  `json:"list"`
 	Fields             []tgField `json:"fields"`
 	IsPrimitiveType    bool      `json:"is_primitive_type"`
+
+	// заполняются в resolveUnions
+	Union       *tgUnion       `json:"union"`
+	VariantOf   []tgVariantOf  `json:"variant_of"`
+	UnionFields []tgUnionField `json:"union_fields"`
+
+	// заполняется в resolveUpdateKinds
+	UpdateKinds []tgUpdateKind `json:"update_kinds"`
+}
+
+// описание объединения типов (например, MessageOrigin или ChatMember)
+type tgUnion struct {
+	Discriminator string      `json:"discriminator"`
+	Decodable     bool        `json:"decodable"`
+	Default       string      `json:"default"`
+	Variants      []tgVariant `json:"variants"`
+}
+
+// вариант объединения и JSON-значение его поля-дискриминатора
+type tgVariant struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// принадлежность типа к объединению
+type tgVariantOf struct {
+	Union string `json:"union"`
+	Field string `json:"field"`
+	Value string `json:"value"`
+}
+
+// поле структуры, содержащее объединение
+type tgUnionField struct {
+	Name     string `json:"name"`
+	JSONName string `json:"json_name"`
+	Union    string `json:"union"`
+	Slice    bool   `json:"slice"`
 }
 
 type tgField struct {
@@ -32,6 +69,19 @@ type tgField struct {
 	Description        string `json:"description"`
 }
 
+// тип обновления - опциональное поле Update
+type tgUpdateKind struct {
+	Const   string `json:"const"`
+	Value   string `json:"value"`
+	Field   string `json:"field"`
+	Default bool   `json:"default"`
+}
+
+// manualTypes типы Telegram Bot API, которые не генерируются, а реализованы вручную
+var manualTypes = map[string]bool{
+	"InputFile": true,
+}
+
 func main() {
 	types := []tgObject{}
 	params := []tgObject{}
@@ -65,6 +115,11 @@ func main() {
 
 		isTgType := unicode.IsUpper(rune(name[0]))
 
+		// типы, реализованные вручную в пакете types
+		if isTgType && manualTypes[name] {
+			continue
+		}
+
 		link := getAttributeValue(h4Tag, "href")
 
 		pTag := getTag(b, "p")
@@ -103,6 +158,12 @@ func main() {
 				}
 
 			}
+
+			// поля, принимающие ссылку attach://<имя>, могут содержать загружаемый файл
+			if fieldType == "string" && strings.Contains(fieldDesc, "attach://") {
+				fieldType = "*InputFile"
+			}
+
 			fields = append(fields, tgField{
 				NameSnakeCase:      fieldNameSnakeCase,
 				NameUpperCamelCase: fieldNameUpperCase,
@@ -134,6 +195,13 @@ func main() {
 		}
 	}
 
+	resolveUnions(types, params)
+	resolveUpdateKinds(types)
+	render(types, params)
+}
+
+// генерация файлов по шаблонам
+func render(types, params []tgObject) {
 	type TemplateData struct {
 		Name       string
 		Path       string
@@ -172,6 +240,153 @@ func main() {
 	}
 }
 
+var (
+	// "Type of the message origin, always “user”", "Scope type, must be default"
+	reStringDiscriminator = regexp.MustCompile(`, (?:always|must be) “?(\w+)”?$`)
+	// "Always 0. The field can be used to differentiate regular and inaccessible messages."
+	reNumberDiscriminator = regexp.MustCompile(`^Always (\d+)\.`)
+)
+
+// поиск значения поля-дискриминатора в описании поля
+func getDiscriminatorValue(f tgField) (string, bool) {
+	if m := reStringDiscriminator.FindStringSubmatch(f.Description); m != nil {
+		return `"` + m[1] + `"`, true
+	}
+	if m := reNumberDiscriminator.FindStringSubmatch(f.Description); m != nil {
+		return m[1], true
+	}
+	return "", false
+}
+
+// resolveUpdateKinds находит типы обновлений по полям Update.
+// Типы, которые нужно явно указать в allowed_updates, не входят в список по умолчанию.
+func resolveUpdateKinds(types []tgObject) {
+	for i := range types {
+		if types[i].Name != "Update" {
+			continue
+		}
+
+		for _, f := range types[i].Fields {
+			if f.NameSnakeCase == "update_id" {
+				continue
+			}
+
+			types[i].UpdateKinds = append(types[i].UpdateKinds, tgUpdateKind{
+				Const:   "UpdateKind" + f.NameUpperCamelCase,
+				Value:   f.NameSnakeCase,
+				Field:   f.NameUpperCamelCase,
+				Default: !strings.Contains(f.Description, "must explicitly specify"),
+			})
+		}
+	}
+}
+
+// resolveUnions заменяет map[string]any для объединений на интерфейсы
+// и находит поле-дискриминатор, по которому выбирается конкретный тип
+func resolveUnions(types, params []tgObject) {
+	index := make(map[string]int, len(types))
+	for i, t := range types {
+		index[t.Name] = i
+	}
+
+	unions := make(map[string]*tgUnion)
+	for i := range types {
+		t := &types[i]
+		if len(t.List) == 0 || len(t.Fields) != 0 {
+			continue
+		}
+
+		// дискриминатор - поле, чаще всего встречающееся с фиксированным значением
+		count := make(map[string]int)
+		for _, name := range t.List {
+			for _, f := range types[index[name]].Fields {
+				if _, ok := getDiscriminatorValue(f); ok {
+					count[f.NameSnakeCase]++
+					break
+				}
+			}
+		}
+		union := &tgUnion{}
+		for name, n := range count {
+			if n > count[union.Discriminator] || (n == count[union.Discriminator] && name < union.Discriminator) {
+				union.Discriminator = name
+			}
+		}
+
+		union.Decodable = union.Discriminator != ""
+		seen := make(map[string]bool)
+		for _, name := range t.List {
+			variant := tgVariant{Name: name}
+			variantOf := tgVariantOf{Union: t.NameUpperCamelCase}
+
+			for _, f := range types[index[name]].Fields {
+				if f.NameSnakeCase != union.Discriminator {
+					continue
+				}
+				if value, ok := getDiscriminatorValue(f); ok {
+					variant.Value = value
+					if strings.HasPrefix(value, `"`) {
+						variantOf.Field = f.NameUpperCamelCase
+						variantOf.Value = strings.Trim(value, `"`)
+					}
+				}
+			}
+
+			// без значения дискриминатора может быть только один вариант, иначе тип нельзя различить
+			if variant.Value == "" {
+				if union.Default != "" {
+					union.Decodable = false
+				}
+				union.Default = name
+			} else if seen[variant.Value] {
+				union.Decodable = false
+			}
+			seen[variant.Value] = true
+
+			union.Variants = append(union.Variants, variant)
+			types[index[name]].VariantOf = append(types[index[name]].VariantOf, variantOf)
+		}
+
+		t.Union = union
+		unions[t.Name] = union
+	}
+
+	// интерфейсы используются без указателя;
+	// структурам из ответов Telegram нужна десериализация полей-объединений
+	resolveFields := func(objects []tgObject, decode bool) {
+		for i := range objects {
+			for j := range objects[i].Fields {
+				f := &objects[i].Fields[j]
+				name := strings.TrimLeft(f.TypeField, "*[]")
+				union, ok := unions[name]
+				if !ok {
+					continue
+				}
+
+				f.TypeField = strings.TrimPrefix(f.TypeField, "*")
+
+				if decode && union.Decodable {
+					objects[i].UnionFields = append(objects[i].UnionFields, tgUnionField{
+						Name:     f.NameUpperCamelCase,
+						JSONName: f.NameSnakeCase,
+						Union:    name,
+						Slice:    strings.HasPrefix(f.TypeField, "[]"),
+					})
+				}
+			}
+		}
+	}
+	resolveFields(types, true)
+	resolveFields(params, false)
+
+	for i := range params {
+		name := strings.TrimPrefix(params[i].ReturnType, "*types.")
+		if _, ok := unions[name]; ok {
+			params[i].ReturnType = "types." + name
+		}
+	}
+}
+
 func createTamplate(path string) *template.Template {
 	// чтение файла с шаблоном
 	dataTemplate, err := os.ReadFile(path)
@@ -273,6 +488,11 @@ func convertTypeTgToGo(n, t string) string {
 		}
 	}
 
+	// идентификатор чата может быть числом или именем пользователя канала
+	if t == "Integer or String" {
+		return prefix + "ChatID"
+	}
+
 	if strings.Count(t, " or ") > 1 {
 		return n
 	}
@@ -327,9 +547,14 @@ func getReturnType(text string) string {
 
 	text = text[indexAnchorWord:]
 
+	// методы редактирования возвращают объект или True для inline-сообщений,
+	// такие объединения описаны вручную в пакете types (например, MessageOrBool)
+	union := strings.Contains(getContent(text), "otherwise True")
+
 	prefix := ""
 	for {
-		indexArray := strings.Index(text, "Array of")
+		// в документации встречается как "Array of", так и "array of"
+		indexArray := strings.Index(strings.ToLower(text), "array of")
 		if indexArray == -1 {
 			break
 		}
@@ -343,6 +568,9 @@ func getReturnType(text string) string {
 		if len(prefix) == 0 {
 			prefix = "*"
 		}
+		if union {
+			return prefix + packageName + "." + innerTagData + "OrBool"
+		}
 		return prefix + packageName + "." + innerTagData
 	}
 